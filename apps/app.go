@@ -1,24 +1,71 @@
 // Package apps exposes a few handy APIs which interact and retrieve information from the kubernetes cluster
+//
+// The APIs returning an error pass the failures of the k8s API either as is or wrapped using "%w", i.e. the underlying
+// *errors.StatusError of "k8s.io/apimachinery/pkg/api/errors" is always preserved. Hence the callers can tell the failures
+// apart using the checks of that package ex:
+//
+//	pod, err := cli.GetPod("default", "web-0")
+//	if apierrors.IsNotFound(err) {
+//		// the pod doesn't exist
+//	} else if apierrors.IsForbidden(err) {
+//		// the client is not allowed to read the pod
+//	}
+//
+// The same holds for `apierrors.IsConflict`, `apierrors.IsAlreadyExists` etc. The errors specific to this package
+// (ex: ErrNoPreviousContainer) are exported as variables to be checked with `errors.Is`.
+//
+// Every list getter GetX has a GetXContext variant which accepts a context.Context as its first argument and passes it
+// to the calls of the k8s API, so that the callers can cancel them or set a deadline. GetX simply delegates to GetXContext
+// with context.Background(). The new list getters are expected to follow the same convention.
+//
+// The namespaced APIs treat an empty string ("") namespace as the client's default namespace, which is the kubernetes' "default" namespace
+// unless it is changed with WithDefaultNamespace. A client created WithStrictNamespace rejects an empty namespace with ErrEmptyNamespace instead.
+//
+// To prevent the naive callers from hanging when the API server stalls, every call to the k8s API, except the watches and the log streams,
+// is bound by a default timeout of 30 seconds when the passed context has no deadline. The default can be changed with WithDefaultTimeout.
+// An explicit deadline on the passed context always takes precedence over the default.
 package apps
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"flag"
+	"fmt"
 	"log"
+	"net/http"
 	"path/filepath"
+	"sync"
 	"time"
 
 	apiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/homedir"
+	metricsv "k8s.io/metrics/pkg/client/clientset/versioned"
+	"sigs.k8s.io/yaml"
 )
 
 const (
 	//  defaultNamespace refers to the kubernetes' "default" namespace
 	defaultNamespace = "default"
+	// defaultRequestTimeout refers to the default deadline of the calls to the k8s API made with a context which has no deadline
+	defaultRequestTimeout = 30 * time.Second
+	// defaultDiscoveryCacheTTL refers to the default time after which the cached discovery information (RESTMapper) is rebuilt
+	defaultDiscoveryCacheTTL = 10 * time.Minute
 )
 
 // configType refers to the types of modes through which the Kubernetes API can be accessed.
@@ -37,28 +84,71 @@ const (
 
 // Client acts as a config holder which interacts with the Kubernetes API
 type Client struct {
-	// Clientset refers to the actual clientset of kubernetes go client that interacts with the Kubernetes API
-	*kubernetes.Clientset
+	// Interface refers to the clientset of kubernetes go client that interacts with the Kubernetes API,
+	// held as an interface so that a fake clientset (k8s.io/client-go/kubernetes/fake) can be used in its place.
+	// It replaces the former embedded *kubernetes.Clientset, i.e. the callers of cli.Clientset have to use cli.Interface instead.
+	kubernetes.Interface
+	// config refers to the rest configuration from which the clientset is built
+	config *rest.Config
+	// metricsClient refers to the clientset of the resource metrics API (metrics.k8s.io) served by the metrics-server
+	metricsClient metricsv.Interface
+	// dynamicClient refers to the client which interacts with any resource (including the custom resources) as unstructured objects
+	dynamicClient dynamic.Interface
+	// mapper refers to the RESTMapper built from the discovery information of the cluster at mapperBuilt, both guarded by the mapperLock
+	mapper      meta.RESTMapper
+	mapperBuilt time.Time
+	mapperLock  sync.Mutex
+	// discoveryCacheTTL refers to the time after which the mapper is rebuilt from fresh discovery information
+	discoveryCacheTTL time.Duration
+	// ctx refers to the lifetime of the client, the watches and informers started by the client are stopped once it is done
+	ctx context.Context
+	// cancel ends the lifetime of the client
+	cancel context.CancelFunc
+	// watchMaxBackoff refers to the cap of the time waited between the attempts of re-establishing a watch
+	watchMaxBackoff time.Duration
+	// defaultTimeout refers to the deadline set on the calls to the k8s API whose context has no deadline, zero disables it
+	defaultTimeout time.Duration
+	// namespace refers to the namespace used by the APIs when an empty string ("") namespace is passed
+	namespace string
+	// strictNamespace refers to whether an empty string ("") namespace is rejected rather than defaulted
+	strictNamespace bool
+	// cacheCtx refers to the lifetime of the read cache requested with WithCache, nil if the reads are not cached
+	cacheCtx context.Context
+	// readCache refers to the informers serving the reads of the pods/deployments, nil if the reads are not cached
+	readCache *readCache
+	// shutdownCtx refers to the context requested with WithShutdownContext whose cancellation closes the client, nil if not set
+	shutdownCtx context.Context
 }
 
-// NewClient is a constructor function which initializes and returns the client that can interact with the Kubernetes API based on the provided configuration type
-func NewClient(confType configType) *Client {
+// ErrClientClosed is returned by the APIs of a client which has been closed
+var ErrClientClosed = errors.New("client closed")
+
+// closedCheckTransport fails the requests to the Kubernetes API with ErrClientClosed once the client has been closed
+type closedCheckTransport struct {
+	cli  *Client
+	next http.RoundTripper
+}
+
+// RoundTrip implements the http.RoundTripper interface
+func (t *closedCheckTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.cli.ctx.Err() != nil {
+		return nil, ErrClientClosed
+	}
+	return t.next.RoundTrip(req)
+}
+
+// NewClient is a constructor function which initializes and returns the client that can interact with the Kubernetes API based on the provided configuration type.
+// The optional functional options tune the rest configuration (timeout, rate limits etc.) before the clientset is built.
+func NewClient(confType configType, opts ...Option) (*Client, error) {
 	log.Printf("Initializing the client configuration, Config Type: %v\n", confType)
+	var config *rest.Config
+	var err error
 	if confType == InCluster {
-		config, err := rest.InClusterConfig()
+		config, err = rest.InClusterConfig()
 		if err != nil {
 			log.Printf("Creating InCluster Configuration failed, Error: %v\n", err)
-			return nil
+			return nil, err
 		}
-
-		// Creating a clientset
-		clientset, err := kubernetes.NewForConfig(config)
-		if err != nil {
-			log.Printf("Clientset creation failed, Error: %v\n", err)
-			return nil
-		}
-		return &Client{clientset}
-
 	} else if confType == OutOfCluster {
 		var kubeconfig *string
 		if home := homedir.HomeDir(); home != "" {
@@ -67,35 +157,239 @@ func NewClient(confType configType) *Client {
 			kubeconfig = flag.String("kubeconfig", "", "absolute path to the kubeconfig file")
 		}
 		flag.Parse()
-		config, err := clientcmd.BuildConfigFromFlags("", *kubeconfig)
+		config, err = clientcmd.BuildConfigFromFlags("", *kubeconfig)
 		if err != nil {
 			log.Printf("Creating Out of Cluster Configuration failed, Error: %v\n", err)
-			return nil
+			return nil, err
 		}
-		// Creating a clientset
-		clientset, err := kubernetes.NewForConfig(config)
-		if err != nil {
-			log.Printf("Clientset creation failed, Error: %v\n", err)
-			return nil
+	} else {
+		log.Printf("Initializing the configuration failed, Invalid Config type: %v\n", confType)
+		return nil, fmt.Errorf("invalid config type: %v", confType)
+	}
+	return newClientForConfig(config, opts...)
+}
+
+// newClientForConfig initializes the client from the given rest configuration, after customizing it with the functional options
+func newClientForConfig(config *rest.Config, opts ...Option) (*Client, error) {
+	var err error
+	cli := &Client{
+		config:            config,
+		watchMaxBackoff:   defaultWatchMaxBackoff,
+		defaultTimeout:    defaultRequestTimeout,
+		discoveryCacheTTL: defaultDiscoveryCacheTTL,
+		namespace:         defaultNamespace,
+	}
+	cli.ctx, cli.cancel = context.WithCancel(context.Background())
+	for _, opt := range opts {
+		if err := opt(cli); err != nil {
+			log.Printf("Applying the client option failed, Error: %v\n", err)
+			return nil, err
 		}
-		return &Client{clientset}
 	}
-	log.Printf("Initializing the configuration failed, Invalid Config type: %v\n", confType)
+	cli.config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &closedCheckTransport{cli: cli, next: rt}
+	})
+	// Creating a clientset
+	cli.Interface, err = kubernetes.NewForConfig(cli.config)
+	if err != nil {
+		log.Printf("Clientset creation failed, Error: %v\n", err)
+		return nil, err
+	}
+	// the metrics API is an aggregated API which is not guaranteed to serve protobuf, hence it is always talked to in JSON (see WithProtobuf)
+	metricsConfig := rest.CopyConfig(cli.config)
+	metricsConfig.ContentType = ""
+	metricsConfig.AcceptContentTypes = ""
+	cli.metricsClient, err = metricsv.NewForConfig(metricsConfig)
+	if err != nil {
+		log.Printf("Metrics clientset creation failed, Error: %v\n", err)
+		return nil, err
+	}
+	cli.dynamicClient, err = dynamic.NewForConfig(cli.config)
+	if err != nil {
+		log.Printf("Dynamic client creation failed, Error: %v\n", err)
+		return nil, err
+	}
+	if cli.cacheCtx != nil {
+		cli.startReadCache(cli.cacheCtx)
+	}
+	if cli.shutdownCtx != nil {
+		context.AfterFunc(cli.shutdownCtx, func() { cli.Close() })
+	}
+	return cli, nil
+}
+
+// Close releases the resources held by the client. It stops the watches and informers started through the client (closing their channels)
+// and the idle connections to the Kubernetes API. The APIs called after Close fail with an error wrapping ErrClientClosed.
+// The consumers of the watch channels observe a clean close once the events in flight are dropped, never a panic.
+// Calling Close more than once is a no-op. See WithShutdownContext to close the client on a termination signal.
+func (cli *Client) Close() error {
+	log.Printf("Closing the client\n")
+	cli.cancel()
+	// the fake clientset returns a typed nil rest client
+	if restClient, ok := cli.CoreV1().RESTClient().(*rest.RESTClient); ok && restClient != nil && restClient.Client != nil {
+		restClient.Client.CloseIdleConnections()
+	}
 	return nil
 }
 
+// ErrEmptyNamespace is returned by the namespaced APIs of a client created WithStrictNamespace when an empty string ("") namespace is passed
+var ErrEmptyNamespace = errors.New("namespace must not be empty")
+
+// resolveNamespace returns the given namespace, or the client's default namespace if it is an empty string ("").
+// With the strict namespace an empty string ("") is rejected with ErrEmptyNamespace instead.
+func (cli *Client) resolveNamespace(namespace string) (string, error) {
+	if namespace != "" {
+		return namespace, nil
+	}
+	if cli.strictNamespace {
+		log.Printf("Invalid namespace, Err: %v", ErrEmptyNamespace)
+		return "", ErrEmptyNamespace
+	}
+	return cli.namespace, nil
+}
+
+// requestContext derives a context with the client's default timeout from the given context if it has no deadline.
+// An explicit deadline of the given context always takes precedence.
+func (cli *Client) requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok || cli.defaultTimeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, cli.defaultTimeout)
+}
+
+// withClientContext returns a context which is cancelled either when the given context is done or when the client is closed
+func (cli *Client) withClientContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	stop := context.AfterFunc(cli.ctx, cancel)
+	context.AfterFunc(ctx, func() { stop() })
+	return ctx, cancel
+}
+
 // Pod represents the information of the pod present in the kubernetes cluster.
 // The info consists of Name of the pod, Status if the pod is Running, Total Restart count of all the containers,
-// The age of the pod since it is up
+// The age of the pod since it is up, the owner (controller) of the pod if any, the aggregate resource requests/limits
+// the placement (node and IP), the Quality of Service class of the pod and the status details of its (init) containers
 type Pod struct {
 	// Name of the pod
-	Name string
+	Name string `json:"name"`
+	// Namespace of the pod
+	Namespace string `json:"namespace"`
 	// Status of the pod ex:"Running/CrashLoopBack/Error" etc.
-	Status string
+	Status string `json:"status"`
+	// Ready represents if the Ready condition of the pod is True, i.e. all of its containers and readiness gates are ready
+	Ready bool `json:"ready"`
 	// RestartCount refers to the sum of the restart counts of all the containers in a pod
-	RestartCount int
+	RestartCount int `json:"restartCount"`
 	// UpTime represents the age of the pod
-	UpTime float64
+	UpTime float64 `json:"upTime"`
+	// AgeString represents the age of the pod in the kubectl style ex:"2d3h/5m/45s", empty until the pod has been started
+	AgeString string `json:"ageString"`
+	// OwnerKind refers to the kind of the first controller/owner of the pod ex:"ReplicaSet/Job/StatefulSet" etc.
+	// It is empty for a bare pod that has no owner.
+	OwnerKind string `json:"ownerKind"`
+	// OwnerName refers to the name of the first controller/owner of the pod, empty for a bare pod
+	OwnerName string `json:"ownerName"`
+	// CPURequest refers to the sum of the CPU requests of all the containers in a pod
+	CPURequest resource.Quantity `json:"cpuRequest"`
+	// MemoryRequest refers to the sum of the memory requests of all the containers in a pod
+	MemoryRequest resource.Quantity `json:"memoryRequest"`
+	// CPULimit refers to the sum of the CPU limits of all the containers in a pod
+	CPULimit resource.Quantity `json:"cpuLimit"`
+	// MemoryLimit refers to the sum of the memory limits of all the containers in a pod
+	MemoryLimit resource.Quantity `json:"memoryLimit"`
+	// NodeName refers to the node on which the pod is scheduled, empty for an unscheduled pod
+	NodeName string `json:"nodeName"`
+	// PodIP refers to the IP address allocated to the pod, empty until the pod is assigned one
+	PodIP string `json:"podIP"`
+	// QOSClass refers to the Quality of Service class of the pod ex:"Guaranteed/Burstable/BestEffort"
+	QOSClass string `json:"qosClass"`
+	// Containers refers to the status details of each container of the pod
+	Containers []ContainerStatus `json:"containers"`
+	// InitContainers refers to the status details of each init container of the pod
+	InitContainers []ContainerStatus `json:"initContainers"`
+}
+
+// ContainerStatus represents the status of a single (init) container of a pod
+type ContainerStatus struct {
+	// Name of the container
+	Name string `json:"name"`
+	// Image refers to the image the container runs ex:"nginx:1.25"
+	Image string `json:"image"`
+	// Ready represents if the container is passing its readiness probe
+	Ready bool `json:"ready"`
+	// RestartCount refers to the number of times the container has been restarted
+	RestartCount int `json:"restartCount"`
+	// State of the container ex:"Waiting/Running/Terminated"
+	State string `json:"state"`
+	// Reason refers to the reason of the current Waiting/Terminated state ex:"CrashLoopBackOff/Completed" etc.
+	Reason string `json:"reason"`
+	// LastTerminationReason refers to the reason of the previous termination of the container if any ex:"OOMKilled/Error"
+	LastTerminationReason string `json:"lastTerminationReason"`
+	// LastTerminationTime refers to the time the previous termination of the container finished, nil if the container never terminated
+	LastTerminationTime *time.Time `json:"lastTerminationTime,omitempty"`
+}
+
+// newContainerStatuses maps the given kubernetes container statuses to the ContainerStatus information
+func newContainerStatuses(statuses []apiv1.ContainerStatus) []ContainerStatus {
+	var containers []ContainerStatus
+	for _, status := range statuses {
+		container := ContainerStatus{
+			Name:         status.Name,
+			Image:        status.Image,
+			Ready:        status.Ready,
+			RestartCount: int(status.RestartCount),
+		}
+		if status.State.Waiting != nil {
+			container.State = "Waiting"
+			container.Reason = status.State.Waiting.Reason
+		} else if status.State.Terminated != nil {
+			container.State = "Terminated"
+			container.Reason = status.State.Terminated.Reason
+		} else if status.State.Running != nil {
+			container.State = "Running"
+		}
+		if status.LastTerminationState.Terminated != nil {
+			container.LastTerminationReason = status.LastTerminationState.Terminated.Reason
+			if finishedAt := status.LastTerminationState.Terminated.FinishedAt; !finishedAt.IsZero() {
+				container.LastTerminationTime = &finishedAt.Time
+			}
+		}
+		containers = append(containers, container)
+	}
+	return containers
+}
+
+// ToJSON returns the JSON encoding of the pod information, the field names follow the lower camel case convention of the Kubernetes API ex:"restartCount"
+func (pod Pod) ToJSON() ([]byte, error) {
+	return json.Marshal(pod)
+}
+
+// FormatAge formats the given duration in the kubectl style, collapsed to its two most significant units
+// (days, hours, minutes and seconds) ex: "45s", "5m", "3h20m", "2d3h". A trailing zero unit is dropped and a negative duration is "0s".
+func FormatAge(d time.Duration) string {
+	units := []struct {
+		suffix string
+		size   time.Duration
+	}{
+		{"d", 24 * time.Hour},
+		{"h", time.Hour},
+		{"m", time.Minute},
+		{"s", time.Second},
+	}
+	for i, unit := range units {
+		if d < unit.size && unit.size != time.Second {
+			continue
+		}
+		age := fmt.Sprintf("%d%s", max(d/unit.size, 0), unit.suffix)
+		if i+1 < len(units) {
+			next := units[i+1]
+			if remainder := (d % unit.size) / next.size; remainder > 0 {
+				age += fmt.Sprintf("%d%s", remainder, next.suffix)
+			}
+		}
+		return age
+	}
+	return "0s"
 }
 
 // getPodPhaseStatus returns the pod status depending upon its containers' statuses
@@ -123,40 +417,893 @@ func getPodRestartCount(pod apiv1.Pod) int32 {
 	return restartCount
 }
 
-// GetPods is an API to fetch the details of all the pods present in a given "namespace". namespace defaults to the "default" if the argument passed is an empty string ("")
+// getPodResources returns the CPU and memory requests and limits of a pod.
+// Each value is the sum of the corresponding values of all the containers present in the given pod,
+// a container without a given request/limit contributes zero.
+func getPodResources(pod apiv1.Pod) (cpuRequest, memoryRequest, cpuLimit, memoryLimit resource.Quantity) {
+	for _, container := range pod.Spec.Containers {
+		cpuRequest.Add(*container.Resources.Requests.Cpu())
+		memoryRequest.Add(*container.Resources.Requests.Memory())
+		cpuLimit.Add(*container.Resources.Limits.Cpu())
+		memoryLimit.Add(*container.Resources.Limits.Memory())
+	}
+	return cpuRequest, memoryRequest, cpuLimit, memoryLimit
+}
+
+// getPodQOSClass returns the Quality of Service class of a pod.
+// The class reported in the pod status is returned if present, else it is computed from the resource requests/limits
+// of the containers the same way as the kubelet does, considering only the CPU and memory resources.
+func getPodQOSClass(pod apiv1.Pod) string {
+	if pod.Status.QOSClass != "" {
+		return string(pod.Status.QOSClass)
+	}
+	requests := apiv1.ResourceList{}
+	limits := apiv1.ResourceList{}
+	isGuaranteed := true
+	containers := append(append([]apiv1.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...)
+	for _, container := range containers {
+		for _, name := range []apiv1.ResourceName{apiv1.ResourceCPU, apiv1.ResourceMemory} {
+			if quantity, ok := container.Resources.Requests[name]; ok && !quantity.IsZero() {
+				total := requests[name]
+				total.Add(quantity)
+				requests[name] = total
+			}
+			if quantity, ok := container.Resources.Limits[name]; ok && !quantity.IsZero() {
+				total := limits[name]
+				total.Add(quantity)
+				limits[name] = total
+			} else {
+				// every container should have both the CPU and memory limits set for the pod to be Guaranteed
+				isGuaranteed = false
+			}
+		}
+	}
+	if len(requests) == 0 && len(limits) == 0 {
+		return string(apiv1.PodQOSBestEffort)
+	}
+	if isGuaranteed {
+		for name, request := range requests {
+			if limit, ok := limits[name]; !ok || limit.Cmp(request) != 0 {
+				isGuaranteed = false
+				break
+			}
+		}
+	}
+	if isGuaranteed && len(requests) == len(limits) {
+		return string(apiv1.PodQOSGuaranteed)
+	}
+	return string(apiv1.PodQOSBurstable)
+}
+
+// hasReadyCondition returns whether the Ready condition of the given pod is True, false if the condition is not reported yet
+func hasReadyCondition(info apiv1.Pod) bool {
+	for _, condition := range info.Status.Conditions {
+		if condition.Type == apiv1.PodReady {
+			return condition.Status == apiv1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// newPod maps the given kubernetes pod object to the Pod information returned by the APIs of this package
+func newPod(info apiv1.Pod) Pod {
+	pod := Pod{
+		Name:           info.ObjectMeta.Name,
+		Namespace:      info.ObjectMeta.Namespace,
+		Status:         getPodPhaseStatus(info),
+		Ready:          hasReadyCondition(info),
+		RestartCount:   int(getPodRestartCount(info)),
+		NodeName:       info.Spec.NodeName,
+		PodIP:          info.Status.PodIP,
+		QOSClass:       getPodQOSClass(info),
+		Containers:     newContainerStatuses(info.Status.ContainerStatuses),
+		InitContainers: newContainerStatuses(info.Status.InitContainerStatuses),
+	}
+	// StartTime is not set until the pod has been accepted by the kubelet
+	if info.Status.StartTime != nil {
+		pod.UpTime = float64(time.Now().Unix() - info.Status.StartTime.Unix())
+		pod.AgeString = FormatAge(time.Duration(pod.UpTime) * time.Second)
+	}
+	if len(info.ObjectMeta.OwnerReferences) > 0 {
+		pod.OwnerKind = info.ObjectMeta.OwnerReferences[0].Kind
+		pod.OwnerName = info.ObjectMeta.OwnerReferences[0].Name
+	}
+	pod.CPURequest, pod.MemoryRequest, pod.CPULimit, pod.MemoryLimit = getPodResources(info)
+	return pod
+}
+
+// listAndMap invokes "list" and maps each of the listed items to the information returned by the APIs, ex: a kubernetes Pod to the Pod.
+// It factors out the "list, map each item, return slice" pattern of the getters so that a new resource type only needs its list call and mapper
+func listAndMap[Src, Dst any](list func() ([]Src, error), mapper func(Src) Dst) ([]Dst, error) {
+	items, err := list()
+	if err != nil {
+		return nil, err
+	}
+	var result []Dst
+	for _, item := range items {
+		result = append(result, mapper(item))
+	}
+	return result, nil
+}
+
+// filterItems returns the items for which "keep" returns true, to be used along with listAndMap for the getters filtering on the raw objects
+func filterItems[T any](items []T, keep func(T) bool) []T {
+	var filtered []T
+	for _, item := range items {
+		if keep(item) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+// listPodObjects lists the kubernetes pod objects present in the given "namespace" matching the list options, from the read cache of a client
+// created WithCache when the cache can serve the list, from the API otherwise. The getters needing more than the Pod information
+// (ex: the annotations or the conditions) list through it so that they are served by the cache as well.
+func (cli *Client) listPodObjects(ctx context.Context, namespace string, opts metav1.ListOptions) ([]apiv1.Pod, error) {
+	if pods, ok := cli.listCachedPods(namespace, opts); ok {
+		return pods, nil
+	}
+	response, err := cli.CoreV1().Pods(namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return response.Items, nil
+}
+
+// listPods lists the pods present in the given "namespace" matching the list options and maps them to the Pod information
+func (cli *Client) listPods(ctx context.Context, namespace string, opts metav1.ListOptions) ([]Pod, error) {
+	return listAndMap(func() ([]apiv1.Pod, error) {
+		return cli.listPodObjects(ctx, namespace, opts)
+	}, newPod)
+}
+
+// GetPods is an API to fetch the details of all the pods present in a given "namespace". namespace defaults to the client's default namespace if the argument passed is an empty string ("")
 func (cli *Client) GetPods(namespace string) []Pod {
-	if namespace == "" {
-		namespace = defaultNamespace
+	return cli.GetPodsContext(context.Background(), namespace)
+}
+
+// GetPodsContext is the context-aware variant of GetPods
+func (cli *Client) GetPodsContext(ctx context.Context, namespace string) []Pod {
+	pods, err := cli.GetPodsWithOptionsContext(ctx, namespace, metav1.ListOptions{})
+	if err != nil {
+		return nil
 	}
-	log.Printf("Getting the pods information, Namespace: %s\n", namespace)
-	var pods []Pod
+	return pods
+}
+
+// GetPodsWithOptions is an API to fetch the details of the pods present in a given "namespace" with the given list options passed as is
+// to the k8s API, ex: label/field selectors, a resource version to read from, or a limit along with a continue token.
+// Note that only the pods are returned, hence the continue token of the next page is not available to the callers (see StreamPods instead).
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetPodsWithOptions(namespace string, opts metav1.ListOptions) ([]Pod, error) {
+	return cli.GetPodsWithOptionsContext(context.Background(), namespace, opts)
+}
+
+// GetPodsWithOptionsContext is the context-aware variant of GetPodsWithOptions
+func (cli *Client) GetPodsWithOptionsContext(ctx context.Context, namespace string, opts metav1.ListOptions) ([]Pod, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the pods information, Namespace: %s, Options: %+v\n", namespace, opts)
 
 	// Getting Pod information
-	response, err := cli.CoreV1().Pods(namespace).List(context.TODO(), metav1.ListOptions{})
+	pods, err := cli.listPods(ctx, namespace, opts)
 	if err != nil {
 		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", pods)
+	return pods, nil
+}
+
+// GetPodsInNamespaces is an API to fetch the details of all the pods present in each of the given "namespaces".
+// The namespaces are listed concurrently through BatchOperation by a pool of "concurrency" workers and the pods are returned keyed by their namespace.
+// The errors of the individual namespaces are joined and returned along with the pods of the namespaces that succeeded.
+// An empty string ("") namespace defaults to the client's default namespace.
+func (cli *Client) GetPodsInNamespaces(ctx context.Context, namespaces []string, concurrency int) (map[string][]Pod, error) {
+	log.Printf("Getting the pods information, Namespaces: %v, Concurrency: %d\n", namespaces, concurrency)
+	resolved := make([]string, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		namespace, err := cli.resolveNamespace(namespace)
+		if err != nil {
+			return nil, err
+		}
+		resolved = append(resolved, namespace)
+	}
+	result := make(map[string][]Pod, len(resolved))
+	var mu sync.Mutex
+	batchErrs := cli.BatchOperation(ctx, resolved, concurrency, func(ctx context.Context, namespace string) error {
+		requestCtx, cancel := cli.requestContext(ctx)
+		defer cancel()
+		pods, err := cli.listPods(requestCtx, namespace, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		result[namespace] = pods
+		mu.Unlock()
 		return nil
+	})
+	var errs []error
+	for i, err := range batchErrs {
+		if err != nil {
+			errs = append(errs, fmt.Errorf("listing pods in namespace %q: %w", resolved[i], err))
+		}
 	}
-	for _, info := range response.Items {
-		pod := new(Pod)
-		pod.Name = info.ObjectMeta.Name
-		pod.Status = getPodPhaseStatus(info)
-		pod.RestartCount = int(getPodRestartCount(info))
-		pod.UpTime = float64(time.Now().Unix() - info.Status.StartTime.Unix())
-		pods = append(pods, *pod)
+
+	err := errors.Join(errs...)
+	if err != nil {
+		log.Printf("Failed getting the pods of a few namespaces, Err: %v", err)
+	}
+	return result, err
+}
+
+// GetPodsByOwner is an API to fetch the details of the pods controlled by the workload identified by "ownerKind" and "ownerName" in the given "namespace".
+// For a "Deployment" the pods are resolved through the replica sets owned by it, the other kinds (ReplicaSet/StatefulSet/Job/DaemonSet etc.)
+// are matched directly against the owner references of the pods. namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetPodsByOwner(namespace, ownerKind, ownerName string) ([]Pod, error) {
+	return cli.GetPodsByOwnerContext(context.Background(), namespace, ownerKind, ownerName)
+}
+
+// GetPodsByOwnerContext is the context-aware variant of GetPodsByOwner
+func (cli *Client) GetPodsByOwnerContext(ctx context.Context, namespace, ownerKind, ownerName string) ([]Pod, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the pods information, Namespace: %s, Owner: %s/%s\n", namespace, ownerKind, ownerName)
+
+	// owners holds the names of the direct owners of the pods to be matched, keyed by their kind
+	owners := map[string]map[string]bool{ownerKind: {ownerName: true}}
+	if ownerKind == "Deployment" {
+		replicaSets, err := cli.AppsV1().ReplicaSets(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			log.Printf("Failed getting response from k8s API, Err: %v", err)
+			return nil, err
+		}
+		owners = map[string]map[string]bool{"ReplicaSet": {}}
+		for _, replicaSet := range replicaSets.Items {
+			for _, owner := range replicaSet.ObjectMeta.OwnerReferences {
+				if owner.Kind == ownerKind && owner.Name == ownerName {
+					owners["ReplicaSet"][replicaSet.ObjectMeta.Name] = true
+				}
+			}
+		}
+	}
+
+	pods, err := listAndMap(func() ([]apiv1.Pod, error) {
+		response, err := cli.listPodObjects(ctx, namespace, metav1.ListOptions{})
+		return filterItems(response, func(info apiv1.Pod) bool {
+			for _, owner := range info.ObjectMeta.OwnerReferences {
+				if owners[owner.Kind][owner.Name] {
+					return true
+				}
+			}
+			return false
+		}), err
+	}, newPod)
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
 	}
 	log.Printf("Fetched information successfully, Info: %v\n", pods)
-	return pods
+	return pods, nil
+}
+
+// GetPodsFiltered is an API to fetch the details of the pods present in a given "namespace" for which the "predicate" returns true.
+// The predicate is evaluated client-side on the Pod information, which lets the callers express arbitrary filters ex:
+//
+//	cli.GetPodsFiltered("default", func(pod Pod) bool { return pod.RestartCount > 5 && pod.Status != "Running" })
+//
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetPodsFiltered(namespace string, predicate func(Pod) bool) ([]Pod, error) {
+	return cli.GetPodsFilteredContext(context.Background(), namespace, predicate)
+}
+
+// GetPodsFilteredContext is the context-aware variant of GetPodsFiltered
+func (cli *Client) GetPodsFilteredContext(ctx context.Context, namespace string, predicate func(Pod) bool) ([]Pod, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the filtered pods information, Namespace: %s\n", namespace)
+	pods, err := cli.listPods(ctx, namespace, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	var filtered []Pod
+	for _, pod := range pods {
+		if predicate(pod) {
+			filtered = append(filtered, pod)
+		}
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", filtered)
+	return filtered, nil
+}
+
+// GetPodsByAnnotation is an API to fetch the details of the pods present in a given "namespace" annotated with the given "key" set to "value".
+// An empty "value" matches any pod having the annotation "key" whatever its value. The annotations can't be selected by the API server,
+// hence the pods are filtered client-side. namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetPodsByAnnotation(namespace, key, value string) ([]Pod, error) {
+	return cli.GetPodsByAnnotationContext(context.Background(), namespace, key, value)
+}
+
+// GetPodsByAnnotationContext is the context-aware variant of GetPodsByAnnotation
+func (cli *Client) GetPodsByAnnotationContext(ctx context.Context, namespace, key, value string) ([]Pod, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the pods information, Namespace: %s, Annotation: %s=%s\n", namespace, key, value)
+	pods, err := listAndMap(func() ([]apiv1.Pod, error) {
+		response, err := cli.listPodObjects(ctx, namespace, metav1.ListOptions{})
+		return filterItems(response, func(info apiv1.Pod) bool {
+			annotation, ok := info.ObjectMeta.Annotations[key]
+			return ok && (value == "" || annotation == value)
+		}), err
+	}, newPod)
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", pods)
+	return pods, nil
+}
+
+// GetPodPhaseCounts is an API to fetch the number of the pods present in a given "namespace" by their status, as computed for the Status
+// field of Pod ex: {"Running": 10, "CrashLoopBackOff": 1}. namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetPodPhaseCounts(namespace string) (map[string]int, error) {
+	return cli.GetPodPhaseCountsContext(context.Background(), namespace)
+}
+
+// GetPodPhaseCountsContext is the context-aware variant of GetPodPhaseCounts
+func (cli *Client) GetPodPhaseCountsContext(ctx context.Context, namespace string) (map[string]int, error) {
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	return cli.countPodPhases(ctx, namespace)
+}
+
+// GetPodPhaseCountsAllNamespaces is an API to fetch the number of the pods across all the namespaces by their status, similar to GetPodPhaseCounts
+func (cli *Client) GetPodPhaseCountsAllNamespaces() (map[string]int, error) {
+	return cli.GetPodPhaseCountsAllNamespacesContext(context.Background())
+}
+
+// GetPodPhaseCountsAllNamespacesContext is the context-aware variant of GetPodPhaseCountsAllNamespaces
+func (cli *Client) GetPodPhaseCountsAllNamespacesContext(ctx context.Context) (map[string]int, error) {
+	return cli.countPodPhases(ctx, metav1.NamespaceAll)
+}
+
+// countPodPhases tallies the pods present in the given "namespace" (all the namespaces if empty) by their status
+func (cli *Client) countPodPhases(ctx context.Context, namespace string) (map[string]int, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	log.Printf("Getting the pod phase counts, Namespace: %s\n", namespace)
+	pods, err := cli.listPods(ctx, namespace, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	counts := make(map[string]int)
+	for _, pod := range pods {
+		counts[pod.Status]++
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", counts)
+	return counts, nil
+}
+
+// GetReadinessSummary is an API to count the pods present in a given "namespace" whose Ready condition is True against the ones whose isn't.
+// Unlike the phase based counts, a Running pod failing its readiness probes is counted as not ready.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetReadinessSummary(namespace string) (ready int, notReady int, err error) {
+	return cli.GetReadinessSummaryContext(context.Background(), namespace)
+}
+
+// GetReadinessSummaryContext is the context-aware variant of GetReadinessSummary
+func (cli *Client) GetReadinessSummaryContext(ctx context.Context, namespace string) (ready int, notReady int, err error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	namespace, err = cli.resolveNamespace(namespace)
+	if err != nil {
+		return 0, 0, err
+	}
+	log.Printf("Getting the pods readiness summary, Namespace: %s\n", namespace)
+	readiness, err := listAndMap(func() ([]apiv1.Pod, error) {
+		return cli.listPodObjects(ctx, namespace, metav1.ListOptions{})
+	}, hasReadyCondition)
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return 0, 0, err
+	}
+	for _, podReady := range readiness {
+		if podReady {
+			ready++
+		} else {
+			notReady++
+		}
+	}
+	log.Printf("Fetched information successfully, Ready: %d, Not Ready: %d\n", ready, notReady)
+	return ready, notReady, nil
+}
+
+// GetPodsGroupedByNode is an API to fetch the details of the pods present in a given "namespace" grouped by the name of the node they are
+// scheduled on, i.e. what is running where. The pods not scheduled yet are grouped under the empty string ("") key.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetPodsGroupedByNode(namespace string) (map[string][]Pod, error) {
+	return cli.GetPodsGroupedByNodeContext(context.Background(), namespace)
+}
+
+// GetPodsGroupedByNodeContext is the context-aware variant of GetPodsGroupedByNode
+func (cli *Client) GetPodsGroupedByNodeContext(ctx context.Context, namespace string) (map[string][]Pod, error) {
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	return cli.groupPodsByNode(ctx, namespace)
+}
+
+// GetPodsGroupedByNodeAllNamespaces is an API to fetch the details of the pods across all the namespaces grouped by the name of their node,
+// similar to GetPodsGroupedByNode. The Namespace of each pod tells the namespaces apart.
+func (cli *Client) GetPodsGroupedByNodeAllNamespaces() (map[string][]Pod, error) {
+	return cli.GetPodsGroupedByNodeAllNamespacesContext(context.Background())
+}
+
+// GetPodsGroupedByNodeAllNamespacesContext is the context-aware variant of GetPodsGroupedByNodeAllNamespaces
+func (cli *Client) GetPodsGroupedByNodeAllNamespacesContext(ctx context.Context) (map[string][]Pod, error) {
+	return cli.groupPodsByNode(ctx, metav1.NamespaceAll)
+}
+
+// groupPodsByNode lists the pods in the given "namespace" (all the namespaces if empty) and groups them by the name of their node
+func (cli *Client) groupPodsByNode(ctx context.Context, namespace string) (map[string][]Pod, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	log.Printf("Getting the pods information grouped by node, Namespace: %s\n", namespace)
+	pods, err := cli.listPods(ctx, namespace, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	nodes := make(map[string][]Pod)
+	for _, pod := range pods {
+		nodes[pod.NodeName] = append(nodes[pod.NodeName], pod)
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", nodes)
+	return nodes, nil
+}
+
+// GetOOMKilledPods is an API to fetch the details of the pods present in a given "namespace" having a container whose last termination was due to
+// running out of memory, i.e. the container's LastTerminationReason in the Containers detail is "OOMKilled".
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetOOMKilledPods(namespace string) ([]Pod, error) {
+	return cli.GetOOMKilledPodsContext(context.Background(), namespace)
+}
+
+// GetOOMKilledPodsContext is the context-aware variant of GetOOMKilledPods
+func (cli *Client) GetOOMKilledPodsContext(ctx context.Context, namespace string) ([]Pod, error) {
+	return cli.GetPodsFilteredContext(ctx, namespace, func(pod Pod) bool {
+		for _, container := range pod.Containers {
+			if container.LastTerminationReason == "OOMKilled" {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// GetRecentlyRestartedPods is an API to fetch the details of the pods present in a given "namespace" having a container whose last termination
+// finished "within" the given window, i.e. the pods restarting right now as opposed to the ones with a high but stale RestartCount.
+// The restarted containers can be told by their LastTerminationTime in the Containers/InitContainers detail, along with their LastTerminationReason.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetRecentlyRestartedPods(namespace string, within time.Duration) ([]Pod, error) {
+	return cli.GetRecentlyRestartedPodsContext(context.Background(), namespace, within)
+}
+
+// GetRecentlyRestartedPodsContext is the context-aware variant of GetRecentlyRestartedPods
+func (cli *Client) GetRecentlyRestartedPodsContext(ctx context.Context, namespace string, within time.Duration) ([]Pod, error) {
+	cutoff := time.Now().Add(-within)
+	return cli.GetPodsFilteredContext(ctx, namespace, func(pod Pod) bool {
+		for _, container := range append(append([]ContainerStatus{}, pod.InitContainers...), pod.Containers...) {
+			if container.LastTerminationTime != nil && container.LastTerminationTime.After(cutoff) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// GetPod is an API to fetch the details of a single pod identified by its "name" in the given "namespace".
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("").
+// The error returned by the k8s API is passed as is, so that the callers can use `apierrors.IsNotFound` on it.
+func (cli *Client) GetPod(namespace, name string) (*Pod, error) {
+	ctx, cancel := cli.requestContext(context.Background())
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the pod information, Namespace: %s, Name: %s\n", namespace, name)
+	response, err := cli.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	pod := newPod(*response)
+	log.Printf("Fetched information successfully, Info: %v\n", pod)
+	return &pod, nil
+}
+
+// GetPodByIP is an API to find the pod owning the given "ip" across all the namespaces, ex: to correlate the network flow logs with the workloads.
+// The pods are looked up by the API server with the field selector "status.podIP=<ip>" and on the client side if the server rejects the field selector.
+// The Namespace of the returned pod tells where it lives. As the completed pods and the host network pods may share the IP, a pod which is not
+// Succeeded/Failed is preferred. A NotFound error (`apierrors.IsNotFound`) is returned if no pod owns the IP.
+func (cli *Client) GetPodByIP(ip string) (*Pod, error) {
+	return cli.GetPodByIPContext(context.Background(), ip)
+}
+
+// GetPodByIPContext is the context-aware variant of GetPodByIP
+func (cli *Client) GetPodByIPContext(ctx context.Context, ip string) (*Pod, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	log.Printf("Getting the pod information, IP: %s\n", ip)
+	fieldSelector := fields.OneTermEqualSelector("status.podIP", ip).String()
+	pods, err := cli.listPods(ctx, metav1.NamespaceAll, metav1.ListOptions{FieldSelector: fieldSelector})
+	if apierrors.IsBadRequest(err) {
+		log.Printf("Field selector on the pod IP is not supported, filtering the pods on the client side, Err: %v", err)
+		pods, err = cli.listPods(ctx, metav1.NamespaceAll, metav1.ListOptions{})
+	}
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	var match *Pod
+	for i := range pods {
+		if pods[i].PodIP != ip {
+			continue
+		}
+		if match == nil || (pods[i].Status != string(apiv1.PodSucceeded) && pods[i].Status != string(apiv1.PodFailed)) {
+			match = &pods[i]
+		}
+	}
+	if match == nil {
+		err := apierrors.NewNotFound(schema.GroupResource{Resource: "pods"}, ip)
+		log.Printf("Failed finding the pod, Err: %v", err)
+		return nil, err
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", *match)
+	return match, nil
+}
+
+// GetPodSpecHash is an API to compute a stable digest of the spec of the pod identified by "podName" in the given "namespace", used to detect
+// the drift of the pods across the environments. The spec is canonicalized by its JSON encoding, which orders the map keys, and hashed with
+// SHA-256, hence identical specs always produce the same hex digest. Note that the fields populated by the cluster, such as the node name or
+// the projected service account token volume, are part of the spec as well. namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetPodSpecHash(namespace, podName string) (string, error) {
+	ctx, cancel := cli.requestContext(context.Background())
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return "", err
+	}
+	log.Printf("Getting the pod spec hash, Namespace: %s, Name: %s\n", namespace, podName)
+	response, err := cli.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return "", err
+	}
+	spec, err := json.Marshal(response.Spec)
+	if err != nil {
+		log.Printf("Failed encoding the pod spec, Err: %v", err)
+		return "", fmt.Errorf("encoding the spec of pod %q: %w", podName, err)
+	}
+	digest := sha256.Sum256(spec)
+	hash := hex.EncodeToString(digest[:])
+	log.Printf("Computed the pod spec hash successfully, Hash: %s\n", hash)
+	return hash, nil
+}
+
+// PodCondition represents a condition of a pod ex: whether it has been scheduled or is ready
+type PodCondition struct {
+	// Type of the condition ex:"PodScheduled/Initialized/ContainersReady/Ready"
+	Type string `json:"type"`
+	// Status of the condition ex:"True/False/Unknown"
+	Status string `json:"status"`
+	// Reason refers to the machine understandable reason of the last transition of the condition ex:"Unschedulable"
+	Reason string `json:"reason"`
+	// Message refers to the human readable details of the last transition of the condition
+	Message string `json:"message"`
+	// LastTransitionTime refers to the time at which the condition last changed its status
+	LastTransitionTime time.Time `json:"lastTransitionTime"`
+}
+
+// GetPodConditions is an API to fetch the conditions of the pod identified by "podName" in the given "namespace", which explain why a pod is stuck
+// ex: PodScheduled=False with the reason "Unschedulable" and the scheduler's message for a pending pod.
+// The error returned by the k8s API is passed as is, so that the callers can use `apierrors.IsNotFound` on it.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetPodConditions(namespace, podName string) ([]PodCondition, error) {
+	ctx, cancel := cli.requestContext(context.Background())
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the pod conditions, Namespace: %s, Name: %s\n", namespace, podName)
+	response, err := cli.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	var conditions []PodCondition
+	for _, condition := range response.Status.Conditions {
+		conditions = append(conditions, PodCondition{
+			Type:               string(condition.Type),
+			Status:             string(condition.Status),
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+			LastTransitionTime: condition.LastTransitionTime.Time,
+		})
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", conditions)
+	return conditions, nil
+}
+
+// DeletePodsByLabel is an API to delete all the pods matching the "labelSelector" in the given "namespace" in a single call.
+// It returns the number of the pods targeted by the deletion. "gracePeriodSeconds" overrides the grace period of the pods if it is not nil.
+// An error is returned if the label selector is invalid. namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) DeletePodsByLabel(namespace, labelSelector string, gracePeriodSeconds *int64, opts ...MutateOption) (int, error) {
+	ctx, cancel := cli.requestContext(context.Background())
+	defer cancel()
+	options := newMutateOptions(opts)
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := labels.Parse(labelSelector); err != nil {
+		log.Printf("Invalid label selector: %q, Err: %v", labelSelector, err)
+		return 0, fmt.Errorf("invalid label selector %q: %w", labelSelector, err)
+	}
+	log.Printf("Deleting the pods, Namespace: %s, Label Selector: %s, Dry Run: %v\n", namespace, labelSelector, options.dryRun)
+	listOptions := metav1.ListOptions{LabelSelector: labelSelector}
+	response, err := cli.CoreV1().Pods(namespace).List(ctx, listOptions)
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return 0, err
+	}
+	deleteOptions := metav1.DeleteOptions{GracePeriodSeconds: gracePeriodSeconds, DryRun: options.dryRunValue()}
+	err = cli.CoreV1().Pods(namespace).DeleteCollection(ctx, deleteOptions, listOptions)
+	if err != nil {
+		log.Printf("Failed deleting the pods, Err: %v", err)
+		return 0, err
+	}
+	log.Printf("Deleted the pods successfully, Count: %d\n", len(response.Items))
+	return len(response.Items), nil
+}
+
+// WaitForPodDeletion is an API to block until the pod identified by "podName" in the given "namespace" is gone.
+// It returns nil as soon as the pod is observed to be deleted or if the pod doesn't exist in the first place. The pod is watched
+// rather than polled, so that a pod re-created with the same name right after the deletion isn't mistaken for the old one.
+// ctx.Err() is returned if the context is done before the deletion (ErrClientClosed if the client is closed in the meantime).
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) WaitForPodDeletion(ctx context.Context, namespace, podName string) error {
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return err
+	}
+	log.Printf("Waiting for the deletion of the pod, Namespace: %s, Name: %s\n", namespace, podName)
+	fieldSelector := fields.OneTermEqualSelector("metadata.name", podName).String()
+	watchCtx, cancel := cli.withClientContext(ctx)
+	defer cancel()
+	deleted := false
+	rw := resourceWatcher{
+		list: func(ctx context.Context) (string, error) {
+			response, err := cli.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{FieldSelector: fieldSelector})
+			if err != nil {
+				return "", err
+			}
+			if len(response.Items) == 0 {
+				// the pod is already gone, possibly deleted while the watch was down
+				deleted = true
+				cancel()
+			}
+			return response.ResourceVersion, nil
+		},
+		watch: func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+			opts.FieldSelector = fieldSelector
+			return cli.CoreV1().Pods(namespace).Watch(ctx, opts)
+		},
+	}
+	resourceVersion, err := rw.list(watchCtx)
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return err
+	}
+	if !deleted {
+		cli.runWatch(watchCtx, resourceVersion, rw, func(event watch.Event) {
+			if event.Type == watch.Deleted {
+				deleted = true
+				cancel()
+			}
+		})
+	}
+	if !deleted {
+		if err := ctx.Err(); err != nil {
+			log.Printf("Stopped waiting for the deletion of the pod, Err: %v", err)
+			return err
+		}
+		log.Printf("Stopped waiting for the deletion of the pod, Err: %v", ErrClientClosed)
+		return ErrClientClosed
+	}
+	log.Printf("Pod deleted successfully\n")
+	return nil
+}
+
+// PodEvent represents a change of a pod observed by WatchPods, or an error of the watch.
+// The consumers are expected to check Err before using the Pod.
+type PodEvent struct {
+	// Type of the change ex:"ADDED/MODIFIED/DELETED", "ERROR" along with Err
+	Type watch.EventType `json:"type"`
+	// Pod refers to the state of the pod after the change, its last known state for a deletion
+	Pod Pod `json:"pod"`
+	// Err refers to the error of converting the object of the change, or of the watch which is being re-established, nil otherwise
+	Err error `json:"-"`
+}
+
+// WatchPods is an API to stream the changes of the pods present in the given "namespace" from now on.
+// Every added, modified or deleted pod is pushed onto the returned channel. The watch is re-established on errors, which are
+// delivered in-band as the events carrying an Err, and the channel is closed once the context is cancelled or the client is closed.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) WatchPods(ctx context.Context, namespace string) (<-chan PodEvent, error) {
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Watching the pods, Namespace: %s\n", namespace)
+	rw := resourceWatcher{
+		list: func(ctx context.Context) (string, error) {
+			// listing a single item is enough to know the current resource version of the collection
+			response, err := cli.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{Limit: 1})
+			if err != nil {
+				return "", err
+			}
+			return response.ResourceVersion, nil
+		},
+		watch: func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+			return cli.CoreV1().Pods(namespace).Watch(ctx, opts)
+		},
+	}
+	ctx, cancel := cli.withClientContext(ctx)
+	resourceVersion, err := rw.list(ctx)
+	if err != nil {
+		cancel()
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+
+	events := make(chan PodEvent)
+	send := func(event PodEvent) {
+		select {
+		case events <- event:
+		case <-ctx.Done():
+		}
+	}
+	rw.onError = func(err error) {
+		send(PodEvent{Type: watch.Error, Err: err})
+	}
+	go func() {
+		defer cancel()
+		defer close(events)
+		cli.runWatch(ctx, resourceVersion, rw, func(watchEvent watch.Event) {
+			info, ok := watchEvent.Object.(*apiv1.Pod)
+			if !ok {
+				send(PodEvent{Type: watchEvent.Type, Err: fmt.Errorf("unexpected object in the pods watch: %T", watchEvent.Object)})
+				return
+			}
+			send(PodEvent{Type: watchEvent.Type, Pod: newPod(*info)})
+		})
+	}()
+	return events, nil
+}
+
+// patchPodMetadata issues a strategic merge patch setting the given key/values under the "field" (labels/annotations) of the pod's metadata.
+// The existing keys which are not present in the given values are preserved.
+func (cli *Client) patchPodMetadata(namespace, name, field string, values map[string]string, opts []MutateOption) error {
+	ctx, cancel := cli.requestContext(context.Background())
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return err
+	}
+	options := newMutateOptions(opts)
+	log.Printf("Patching the pod %s, Namespace: %s, Name: %s, Values: %v, Dry Run: %v\n", field, namespace, name, values, options.dryRun)
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			field: values,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = cli.CoreV1().Pods(namespace).Patch(ctx, name, types.StrategicMergePatchType, patch, metav1.PatchOptions{DryRun: options.dryRunValue()})
+	if err != nil {
+		log.Printf("Failed patching the pod, Err: %v", err)
+		return err
+	}
+	return nil
+}
+
+// LabelPod is an API to add/update the given "labels" on the pod identified by its "name" in the given "namespace".
+// The existing labels of the pod which are not present in the map are preserved.
+// The error returned by the k8s API is passed as is, so that the callers can use `apierrors.IsNotFound` on it.
+func (cli *Client) LabelPod(namespace, name string, labels map[string]string, opts ...MutateOption) error {
+	return cli.patchPodMetadata(namespace, name, "labels", labels, opts)
+}
+
+// AnnotatePod is an API to add/update the given "annotations" on the pod identified by its "name" in the given "namespace".
+// The existing annotations of the pod which are not present in the map are preserved.
+// The error returned by the k8s API is passed as is, so that the callers can use `apierrors.IsNotFound` on it.
+func (cli *Client) AnnotatePod(namespace, name string, annotations map[string]string, opts ...MutateOption) error {
+	return cli.patchPodMetadata(namespace, name, "annotations", annotations, opts)
+}
+
+// CreatePodFromManifest is an API to create a pod from the given YAML "manifest" and returns the details of the created pod.
+// The explicit "namespace" argument takes precedence over the namespace present in the manifest, the manifest's namespace is used only
+// when the argument is an empty string (""), falling back to the client's default namespace when neither is set.
+// The creation error (including the validation errors) returned by the k8s API is passed as is.
+func (cli *Client) CreatePodFromManifest(namespace string, manifest []byte, opts ...MutateOption) (*Pod, error) {
+	ctx, cancel := cli.requestContext(context.Background())
+	defer cancel()
+	options := newMutateOptions(opts)
+	var info apiv1.Pod
+	if err := yaml.Unmarshal(manifest, &info); err != nil {
+		log.Printf("Decoding the pod manifest failed, Err: %v", err)
+		return nil, fmt.Errorf("decoding the pod manifest: %w", err)
+	}
+	if namespace == "" {
+		namespace = info.ObjectMeta.Namespace
+	}
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	info.ObjectMeta.Namespace = namespace
+	log.Printf("Creating the pod, Namespace: %s, Name: %s, Dry Run: %v\n", namespace, info.ObjectMeta.Name, options.dryRun)
+	response, err := cli.CoreV1().Pods(namespace).Create(ctx, &info, metav1.CreateOptions{DryRun: options.dryRunValue()})
+	if err != nil {
+		log.Printf("Failed creating the pod, Err: %v", err)
+		return nil, err
+	}
+	pod := newPod(*response)
+	log.Printf("Created the pod successfully, Info: %v\n", pod)
+	return &pod, nil
 }
 
 // GetEvents is an API to fetch the events that were recorded in the kubernetes cluster
-// "namespace" defaults to the "default" if provided as an empty string("")
+// "namespace" defaults to the client's default namespace if provided as an empty string("")
 func (cli *Client) GetEvents(namespace string) interface{} {
-	if namespace == "" {
-		namespace = defaultNamespace
+	return cli.GetEventsContext(context.Background(), namespace)
+}
+
+// GetEventsContext is the context-aware variant of GetEvents
+func (cli *Client) GetEventsContext(ctx context.Context, namespace string) interface{} {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil
 	}
 	log.Printf("Getting the events information, Namespace: %s\n", namespace)
-	events, err := cli.CoreV1().Events(namespace).List(context.TODO(), metav1.ListOptions{})
+	events, err := cli.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		log.Printf("Failed getting response from k8s API, Err: %v", err)
 		return nil
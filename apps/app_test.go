@@ -0,0 +1,108 @@
+package apps
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// TestCloseFakeClient checks that a client backed by the fake clientset, whose rest client is a typed nil, can be closed (twice)
+func TestCloseFakeClient(t *testing.T) {
+	cli, _ := newFakeClient(t)
+	if err := cli.Close(); err != nil {
+		t.Fatalf("closing the client failed, Err: %v", err)
+	}
+	if err := cli.ctx.Err(); err == nil {
+		t.Errorf("expected the lifetime of the client to be over after Close")
+	}
+	if err := cli.Close(); err != nil {
+		t.Fatalf("closing the client again failed, Err: %v", err)
+	}
+}
+
+// TestCloseMultiClientFakeClients checks that the fake-backed clients of a fleet, and the missing ones, are skipped over by MultiClient.Close
+func TestCloseMultiClientFakeClients(t *testing.T) {
+	cli, _ := newFakeClient(t)
+	multi := &MultiClient{clients: map[string]*Client{"prod": cli, "staging": nil}}
+	if err := multi.Close(); err != nil {
+		t.Fatalf("closing the clients failed, Err: %v", err)
+	}
+	if err := cli.ctx.Err(); err == nil {
+		t.Errorf("expected the lifetime of the client to be over after Close")
+	}
+}
+
+// TestGetPodsInNamespaces checks that the pods are keyed by their namespace and that the failure of a namespace is
+// returned along with the pods of the other namespaces
+func TestGetPodsInNamespaces(t *testing.T) {
+	cli, clientset := newFakeClient(t,
+		&apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-0", Namespace: "default"}},
+		&apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default"}},
+		&apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "db-0", Namespace: "data"}},
+	)
+	errForbidden := errors.New("forbidden")
+	clientset.PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetNamespace() == "restricted" {
+			return true, nil, errForbidden
+		}
+		return false, nil, nil
+	})
+
+	pods, err := cli.GetPodsInNamespaces(context.Background(), []string{"", "data", "restricted"}, 2)
+	if !errors.Is(err, errForbidden) {
+		t.Errorf("expected the error of the restricted namespace, got: %v", err)
+	}
+	if len(pods["default"]) != 2 || len(pods["data"]) != 1 {
+		t.Errorf("expected the pods of the default and data namespaces, got: %v", pods)
+	}
+	if _, ok := pods["restricted"]; ok {
+		t.Errorf("expected no pods for the restricted namespace, got: %v", pods["restricted"])
+	}
+}
+
+// TestFormatAge checks that the ages are collapsed to their two most significant units
+func TestFormatAge(t *testing.T) {
+	tests := []struct {
+		age  time.Duration
+		want string
+	}{
+		{age: 0, want: "0s"},
+		{age: -time.Minute, want: "0s"},
+		{age: 45 * time.Second, want: "45s"},
+		{age: 90 * time.Second, want: "1m30s"},
+		{age: 5 * time.Minute, want: "5m"},
+		{age: 3*time.Hour + 20*time.Minute + 10*time.Second, want: "3h20m"},
+		{age: 51*time.Hour + 30*time.Minute, want: "2d3h"},
+		{age: 48 * time.Hour, want: "2d"},
+	}
+	for _, test := range tests {
+		if got := FormatAge(test.age); got != test.want {
+			t.Errorf("expected FormatAge(%v) to be %q, got: %q", test.age, test.want, got)
+		}
+	}
+}
+
+// TestListAndMap checks that the listed items are mapped in order and that the error of the list is returned as is
+func TestListAndMap(t *testing.T) {
+	names, err := listAndMap(func() ([]string, error) {
+		return []string{"web-0", "db-0", "web-1"}, nil
+	}, strings.ToUpper)
+	if err != nil || strings.Join(names, ",") != "WEB-0,DB-0,WEB-1" {
+		t.Errorf("expected the mapped names, got: %v, Err: %v", names, err)
+	}
+	errList := errors.New("list failed")
+	if _, err := listAndMap(func() ([]string, error) { return nil, errList }, strings.ToUpper); !errors.Is(err, errList) {
+		t.Errorf("expected the error of the list, got: %v", err)
+	}
+	web := filterItems(names, func(name string) bool { return strings.HasPrefix(name, "WEB") })
+	if strings.Join(web, ",") != "WEB-0,WEB-1" {
+		t.Errorf("expected the filtered names, got: %v", web)
+	}
+}
@@ -0,0 +1,83 @@
+package apps
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// ApplyManifest is an API to apply the objects of the given YAML/JSON "manifest" using server-side apply with the given "fieldManager".
+// A manifest can hold multiple documents separated by "---", each of them is applied and the errors of the individual documents are joined,
+// so that the partial failures are visible. The resource of each object is resolved through the client's RESTMapper (see ResolveGVR) and
+// the namespaced objects without a namespace are applied in the client's default namespace.
+func (cli *Client) ApplyManifest(ctx context.Context, manifest []byte, fieldManager string, opts ...MutateOption) error {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	options := newMutateOptions(opts)
+	log.Printf("Applying the manifest, Field Manager: %s, Dry Run: %v\n", fieldManager, options.dryRun)
+	var errs []error
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), 4096)
+	for index := 0; ; index++ {
+		var document map[string]interface{}
+		if err := decoder.Decode(&document); err != nil {
+			if !errors.Is(err, io.EOF) {
+				// the decoder can't resume after a malformed document
+				errs = append(errs, fmt.Errorf("decoding the document %d: %w", index, err))
+			}
+			break
+		}
+		if len(document) == 0 {
+			continue
+		}
+		object := &unstructured.Unstructured{Object: document}
+		if err := cli.applyObject(ctx, object, fieldManager, options); err != nil {
+			errs = append(errs, fmt.Errorf("applying %s %q of the document %d: %w", object.GetKind(), object.GetName(), index, err))
+		}
+	}
+
+	err := errors.Join(errs...)
+	if err != nil {
+		log.Printf("Failed applying the manifest, Err: %v", err)
+		return err
+	}
+	log.Printf("Applied the manifest successfully\n")
+	return nil
+}
+
+// applyObject applies the given object using a server-side apply patch on the resource resolved by the client's RESTMapper
+func (cli *Client) applyObject(ctx context.Context, object *unstructured.Unstructured, fieldManager string, options mutateOptions) error {
+	gvk := object.GroupVersionKind()
+	var mapping *meta.RESTMapping
+	err := cli.mapWithRefresh(func(mapper meta.RESTMapper) error {
+		var err error
+		mapping, err = mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	namespace := ""
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		if object.GetNamespace() == "" {
+			object.SetNamespace(cli.namespace)
+		}
+		namespace = object.GetNamespace()
+	}
+	data, err := json.Marshal(object.Object)
+	if err != nil {
+		return err
+	}
+	patchOptions := metav1.PatchOptions{FieldManager: fieldManager, DryRun: options.dryRunValue()}
+	_, err = cli.dynamicResource(mapping.Resource, namespace).Patch(ctx, object.GetName(), types.ApplyPatchType, data, patchOptions)
+	return err
+}
@@ -0,0 +1,52 @@
+package apps
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	"k8s.io/client-go/rest"
+)
+
+// BatchOperation runs "fn" on each of the given "items" (ex: pod names) using a pool of "concurrency" workers and returns the
+// per-item errors, i.e. the error at index i belongs to items[i] and is nil if the operation on that item succeeded.
+// All the calls of the client share client-go's rate limiter, hence the concurrency is capped by the client's burst (WithBurst)
+// since the extra workers would only wait on the limiter. The items not yet processed when the context is done get ctx.Err().
+func (cli *Client) BatchOperation(ctx context.Context, items []string, concurrency int, fn func(ctx context.Context, item string) error) []error {
+	burst := cli.config.Burst
+	if burst <= 0 {
+		burst = rest.DefaultBurst
+	}
+	concurrency = max(1, min(concurrency, burst, len(items)))
+	log.Printf("Running the batch operation, Items: %d, Concurrency: %d\n", len(items), concurrency)
+	errs := make([]error, len(items))
+	var wg sync.WaitGroup
+	jobs := make(chan int)
+	for worker := 0; worker < concurrency; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if err := ctx.Err(); err != nil {
+					errs[i] = err
+					continue
+				}
+				errs[i] = fn(ctx, items[i])
+			}
+		}()
+	}
+	for i := range items {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	failed := 0
+	for _, err := range errs {
+		if err != nil {
+			failed++
+		}
+	}
+	log.Printf("Completed the batch operation, Items: %d, Failed: %d\n", len(items), failed)
+	return errs
+}
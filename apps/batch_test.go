@@ -0,0 +1,69 @@
+package apps
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+// TestBatchOperationErrors checks that the error at each index belongs to the item at the same index
+func TestBatchOperationErrors(t *testing.T) {
+	cli, _ := newFakeClient(t)
+	items := []string{"web-0", "db-0", "web-1", "db-1"}
+	errDB := errors.New("db is busy")
+	errs := cli.BatchOperation(context.Background(), items, 3, func(ctx context.Context, item string) error {
+		if item[:2] == "db" {
+			return errDB
+		}
+		return nil
+	})
+	if len(errs) != len(items) {
+		t.Fatalf("expected %d errors, got: %d", len(items), len(errs))
+	}
+	for i, item := range items {
+		if want := item[:2] == "db"; errors.Is(errs[i], errDB) != want {
+			t.Errorf("unexpected error of the item %q: %v", item, errs[i])
+		}
+	}
+}
+
+// TestBatchOperationConcurrency checks that the number of workers is capped by the burst of the client
+func TestBatchOperationConcurrency(t *testing.T) {
+	cli, _ := newFakeClient(t)
+	cli.config.Burst = 2
+	items := make([]string, 20)
+	var mu sync.Mutex
+	running, peak := 0, 0
+	cli.BatchOperation(context.Background(), items, 10, func(ctx context.Context, item string) error {
+		mu.Lock()
+		running++
+		peak = max(peak, running)
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			running--
+			mu.Unlock()
+		}()
+		return nil
+	})
+	if peak > 2 {
+		t.Errorf("expected at most 2 concurrent workers, got: %d", peak)
+	}
+}
+
+// TestBatchOperationContextDone checks that the items are not processed and get the error of the context once it is done
+func TestBatchOperationContextDone(t *testing.T) {
+	cli, _ := newFakeClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	errs := cli.BatchOperation(ctx, []string{"web-0", "web-1"}, 2, func(ctx context.Context, item string) error {
+		t.Errorf("unexpected call for the item %q", item)
+		return nil
+	})
+	for i, err := range errs {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected the item %d to get context.Canceled, got: %v", i, err)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// PodCache serves the pods information of a namespace from a local store which is kept up to date by a shared informer in the background.
+// It turns the repeated list calls to the Kubernetes API into a single watch.
+type PodCache struct {
+	// namespace refers to the namespace whose pods are cached
+	namespace string
+	// informer refers to the shared informer which keeps the local store updated
+	informer cache.SharedIndexInformer
+	// lister refers to the lister which reads the pods from the local store
+	lister corelisters.PodLister
+	// cli refers to the client the cache was created by, used for its default timeout
+	cli *Client
+	// lastErr refers to the most recent error of listing/watching the pods
+	lastErr error
+	// lastErrLock guards lastErr
+	lastErrLock sync.Mutex
+}
+
+// NewPodCache is a constructor function which starts a shared informer caching the pods of the given "namespace".
+// The informer keeps running in the background until the given context is cancelled or the client is closed.
+// Call WaitForSync before reading from the cache to make sure the initial list has been stored. A failing list/watch, ex: due to missing
+// permissions or an unreachable API server, is retried by the informer with an exponential backoff (capped to 30 seconds) rather than
+// leaving the cache permanently empty, and the failure is logged and reported by WaitForSync.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) NewPodCache(ctx context.Context, namespace string) (*PodCache, error) {
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Starting the pod cache, Namespace: %s\n", namespace)
+	if cli.ctx.Err() != nil {
+		return nil, ErrClientClosed
+	}
+	// the informer is stopped once the context is done (the derived context is cancelled along with it) or the client is closed
+	ctx, cancel := cli.withClientContext(ctx)
+	factory := informers.NewSharedInformerFactoryWithOptions(cli.Interface, 0, informers.WithNamespace(namespace))
+	podInformer := factory.Core().V1().Pods()
+	podCache := &PodCache{
+		namespace: namespace,
+		informer:  podInformer.Informer(),
+		lister:    podInformer.Lister(),
+		cli:       cli,
+	}
+	// the reflector of the informer retries on its own, the handler only records the error for WaitForSync
+	err = podCache.informer.SetWatchErrorHandler(func(_ *cache.Reflector, err error) {
+		log.Printf("Failed listing/watching the pods of the cache, retrying, Namespace: %s, Err: %v", namespace, err)
+		podCache.lastErrLock.Lock()
+		podCache.lastErr = err
+		podCache.lastErrLock.Unlock()
+	})
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	factory.Start(ctx.Done())
+	go cancelOnShutdown(ctx, cancel, factory)
+	return podCache, nil
+}
+
+// cancelOnShutdown releases the context of the informers started by the factory, through its cancel func, once the informers have stopped running
+func cancelOnShutdown(ctx context.Context, cancel context.CancelFunc, factory informers.SharedInformerFactory) {
+	defer cancel()
+	<-ctx.Done()
+	// Shutdown returns once the run loops of all the informers have exited
+	factory.Shutdown()
+}
+
+// WaitForSync blocks until the initial list of the pods has been stored in the cache or the given context is done, whose deadline
+// bounds the sync. The client's default timeout applies when the context has no deadline, so that a cache which can't sync never hangs the caller.
+// The returned error wraps the error of the context along with the last list/watch error, if any, which explains why the cache didn't sync.
+func (pc *PodCache) WaitForSync(ctx context.Context) error {
+	ctx, cancel := pc.cli.requestContext(ctx)
+	defer cancel()
+	if !cache.WaitForCacheSync(ctx.Done(), pc.informer.HasSynced) {
+		pc.lastErrLock.Lock()
+		lastErr := pc.lastErr
+		pc.lastErrLock.Unlock()
+		if lastErr != nil {
+			return fmt.Errorf("waiting for the pod cache of namespace %q to sync: %w: %w", pc.namespace, ctx.Err(), lastErr)
+		}
+		return fmt.Errorf("waiting for the pod cache of namespace %q to sync: %w", pc.namespace, ctx.Err())
+	}
+	return nil
+}
+
+// List returns the details of all the pods present in the cache
+func (pc *PodCache) List() ([]Pod, error) {
+	return listAndMap(func() ([]*apiv1.Pod, error) {
+		return pc.lister.Pods(pc.namespace).List(labels.Everything())
+	}, func(info *apiv1.Pod) Pod {
+		return newPod(*info)
+	})
+}
+
+// Get returns the details of the pod identified by its "name" from the cache.
+// A not-found error is returned, which satisfies `apierrors.IsNotFound`, when the pod is not present in the cache.
+func (pc *PodCache) Get(name string) (*Pod, error) {
+	info, err := pc.lister.Pods(pc.namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	pod := newPod(*info)
+	return &pod, nil
+}
+
+// readCache holds the cluster-wide shared informers serving the reads of a client created WithCache
+type readCache struct {
+	// pods refers to the informer of the pods and podLister reads from its store
+	pods      cache.SharedIndexInformer
+	podLister corelisters.PodLister
+	// deployments refers to the informer of the deployments and deploymentLister reads from its store
+	deployments      cache.SharedIndexInformer
+	deploymentLister appslisters.DeploymentLister
+}
+
+// startReadCache starts the shared informers of the read cache, which keep running until the given context is done or the client is closed
+func (cli *Client) startReadCache(ctx context.Context) {
+	log.Printf("Starting the read cache of the client\n")
+	ctx, cancel := cli.withClientContext(ctx)
+	factory := informers.NewSharedInformerFactory(cli.Interface, 0)
+	podInformer := factory.Core().V1().Pods()
+	deploymentInformer := factory.Apps().V1().Deployments()
+	cli.readCache = &readCache{
+		pods:             podInformer.Informer(),
+		podLister:        podInformer.Lister(),
+		deployments:      deploymentInformer.Informer(),
+		deploymentLister: deploymentInformer.Lister(),
+	}
+	factory.Start(ctx.Done())
+	go cancelOnShutdown(ctx, cancel, factory)
+}
+
+// isCacheable returns whether a list with the given options can be served from the read cache, which supports only the label selectors
+func isCacheable(opts metav1.ListOptions) bool {
+	return opts.FieldSelector == "" && opts.ResourceVersion == "" && opts.Limit == 0 && opts.Continue == ""
+}
+
+// listCachedPods lists the pods in the given "namespace" (all the namespaces if empty) from the read cache, sorted by their namespace and name
+// like the API does. It returns false if the list has to be served by the API instead.
+func (cli *Client) listCachedPods(namespace string, opts metav1.ListOptions) ([]apiv1.Pod, bool) {
+	if cli.readCache == nil || !cli.readCache.pods.HasSynced() || !isCacheable(opts) {
+		return nil, false
+	}
+	// an invalid selector is left to the API to reject with a proper error
+	selector, err := labels.Parse(opts.LabelSelector)
+	if err != nil {
+		return nil, false
+	}
+	// the namespaced lister lists all the namespaces for an empty namespace
+	response, err := cli.readCache.podLister.Pods(namespace).List(selector)
+	if err != nil {
+		return nil, false
+	}
+	sort.Slice(response, func(i, j int) bool {
+		if response[i].Namespace != response[j].Namespace {
+			return response[i].Namespace < response[j].Namespace
+		}
+		return response[i].Name < response[j].Name
+	})
+	var pods []apiv1.Pod
+	for _, info := range response {
+		pods = append(pods, *info)
+	}
+	return pods, true
+}
+
+// listCachedDeployments lists the deployments in the given "namespace" (all the namespaces if empty) from the read cache, sorted by their
+// namespace and name like the API does. It returns false if the list has to be served by the API instead.
+func (cli *Client) listCachedDeployments(namespace string) ([]appsv1.Deployment, bool) {
+	if cli.readCache == nil || !cli.readCache.deployments.HasSynced() {
+		return nil, false
+	}
+	response, err := cli.readCache.deploymentLister.Deployments(namespace).List(labels.Everything())
+	if err != nil {
+		return nil, false
+	}
+	sort.Slice(response, func(i, j int) bool {
+		if response[i].Namespace != response[j].Namespace {
+			return response[i].Namespace < response[j].Namespace
+		}
+		return response[i].Name < response[j].Name
+	})
+	var deployments []appsv1.Deployment
+	for _, info := range response {
+		deployments = append(deployments, *info)
+	}
+	return deployments, true
+}
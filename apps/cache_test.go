@@ -0,0 +1,99 @@
+package apps
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+)
+
+// countLists returns the number of list requests of the given resource recorded by the fake clientset
+func countLists(clientset *fake.Clientset, resource string) int {
+	count := 0
+	for _, action := range clientset.Actions() {
+		if action.GetVerb() == "list" && action.GetResource().Resource == resource {
+			count++
+		}
+	}
+	return count
+}
+
+// TestIsCacheable checks that only the list options the informers can honor are served by the read cache
+func TestIsCacheable(t *testing.T) {
+	tests := []struct {
+		name string
+		opts metav1.ListOptions
+		want bool
+	}{
+		{name: "empty", opts: metav1.ListOptions{}, want: true},
+		{name: "label selector", opts: metav1.ListOptions{LabelSelector: "app=web"}, want: true},
+		{name: "field selector", opts: metav1.ListOptions{FieldSelector: "status.phase=Pending"}, want: false},
+		{name: "resource version", opts: metav1.ListOptions{ResourceVersion: "5"}, want: false},
+		{name: "limit", opts: metav1.ListOptions{Limit: 10}, want: false},
+		{name: "continue", opts: metav1.ListOptions{Continue: "token"}, want: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := isCacheable(test.opts); got != test.want {
+				t.Errorf("expected isCacheable to be %v, got: %v", test.want, got)
+			}
+		})
+	}
+}
+
+// TestReadCacheFallback checks that the lists are served by the read cache once it has synced, and by the API for the
+// list options the cache can't honor or when the client has no cache
+func TestReadCacheFallback(t *testing.T) {
+	cli, clientset := newFakeClient(t,
+		&apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default", Labels: map[string]string{"app": "web"}}},
+		&apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-0", Namespace: "default", Labels: map[string]string{"app": "web"}}},
+		&apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "db-0", Namespace: "default"}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}},
+	)
+	ctx := context.Background()
+
+	if _, err := cli.listPodObjects(ctx, "default", metav1.ListOptions{}); err != nil {
+		t.Fatalf("listing the pods failed, Err: %v", err)
+	}
+	if got := countLists(clientset, "pods"); got != 1 {
+		t.Fatalf("expected the list to be served by the API without a cache, got %d list requests", got)
+	}
+
+	cli.startReadCache(ctx)
+	syncCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if !cache.WaitForCacheSync(syncCtx.Done(), cli.readCache.pods.HasSynced, cli.readCache.deployments.HasSynced) {
+		t.Fatalf("the read cache failed to sync")
+	}
+	clientset.ClearActions()
+
+	pods, err := cli.listPodObjects(ctx, "default", metav1.ListOptions{LabelSelector: "app=web"})
+	if err != nil {
+		t.Fatalf("listing the pods failed, Err: %v", err)
+	}
+	if len(pods) != 2 || pods[0].Name != "web-0" || pods[1].Name != "web-1" {
+		t.Errorf("expected the sorted pods matching the selector, got: %v", pods)
+	}
+	deployments, err := cli.listDeploymentObjects(ctx, "default")
+	if err != nil {
+		t.Fatalf("listing the deployments failed, Err: %v", err)
+	}
+	if len(deployments) != 1 || deployments[0].Name != "web" {
+		t.Errorf("expected the cached deployment, got: %v", deployments)
+	}
+	if got := countLists(clientset, "pods") + countLists(clientset, "deployments"); got != 0 {
+		t.Errorf("expected the lists to be served by the cache, got %d list requests", got)
+	}
+
+	if _, err := cli.listPodObjects(ctx, "default", metav1.ListOptions{Limit: 1}); err != nil {
+		t.Fatalf("listing the pods failed, Err: %v", err)
+	}
+	if got := countLists(clientset, "pods"); got != 1 {
+		t.Errorf("expected the paginated list to fall back to the API, got %d list requests", got)
+	}
+}
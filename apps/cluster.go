@@ -0,0 +1,139 @@
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+// ClusterSummary represents the total count of a few types of the resources present in the kubernetes cluster
+type ClusterSummary struct {
+	// Nodes refers to the count of the nodes in the cluster
+	Nodes int `json:"nodes"`
+	// Namespaces refers to the count of the namespaces in the cluster
+	Namespaces int `json:"namespaces"`
+	// Pods refers to the count of the pods across all the namespaces by their phase ex:"Running/Pending/Succeeded" etc.
+	Pods map[string]int `json:"pods"`
+	// Deployments refers to the count of the deployments across all the namespaces
+	Deployments int `json:"deployments"`
+	// Services refers to the count of the services across all the namespaces
+	Services int `json:"services"`
+}
+
+// GetClusterSummary is an API to fetch the counts of the nodes, namespaces, pods, deployments and services of the cluster.
+// The counts are fetched concurrently. If any of them fails, the partial summary is returned along with the joined error
+// so that the counts which succeeded can still be consumed.
+func (cli *Client) GetClusterSummary(ctx context.Context) (ClusterSummary, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	log.Printf("Getting the cluster summary\n")
+	var summary ClusterSummary
+	var mu sync.Mutex
+	var errs []error
+	// collect records the failure of a sub-call instead of failing the whole group, each sub-call sets a distinct field of the summary
+	collect := func(resource string, fetch func() error) func() error {
+		return func() error {
+			if err := fetch(); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("counting %s: %w", resource, err))
+				mu.Unlock()
+			}
+			return nil
+		}
+	}
+
+	var group errgroup.Group
+	group.Go(collect("nodes", func() error {
+		nodes, err := cli.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		summary.Nodes = len(nodes.Items)
+		return nil
+	}))
+	group.Go(collect("namespaces", func() error {
+		namespaces, err := cli.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		summary.Namespaces = len(namespaces.Items)
+		return nil
+	}))
+	group.Go(collect("pods", func() error {
+		pods, err := cli.listPodObjects(ctx, metav1.NamespaceAll, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		phases := make(map[string]int)
+		for _, pod := range pods {
+			phases[string(pod.Status.Phase)]++
+		}
+		summary.Pods = phases
+		return nil
+	}))
+	group.Go(collect("deployments", func() error {
+		deployments, err := cli.AppsV1().Deployments(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		summary.Deployments = len(deployments.Items)
+		return nil
+	}))
+	group.Go(collect("services", func() error {
+		services, err := cli.CoreV1().Services(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		summary.Services = len(services.Items)
+		return nil
+	}))
+	_ = group.Wait()
+
+	err := errors.Join(errs...)
+	if err != nil {
+		log.Printf("Failed getting the complete cluster summary, Err: %v", err)
+		return summary, err
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", summary)
+	return summary, nil
+}
+
+// ClusterInfo represents the connection details of the kubernetes cluster the client talks to
+type ClusterInfo struct {
+	// Host refers to the address of the API server ex:"https://10.0.0.1:6443"
+	Host string `json:"host"`
+	// Version refers to the version of the API server ex:"v1.30.2"
+	Version string `json:"version"`
+	// Platform refers to the OS/architecture the API server is built for ex:"linux/amd64"
+	Platform string `json:"platform"`
+}
+
+// GetClusterInfo is an API to fetch the address of the API server, from the client's configuration, along with its version and platform
+// reported by the discovery endpoint "/version", the kind of information printed by a CLI on startup.
+func (cli *Client) GetClusterInfo() (ClusterInfo, error) {
+	ctx, cancel := cli.requestContext(context.Background())
+	defer cancel()
+	log.Printf("Getting the cluster information\n")
+	info := ClusterInfo{Host: cli.config.Host}
+	body, err := cli.Discovery().RESTClient().Get().AbsPath("/version").DoRaw(ctx)
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return info, err
+	}
+	var serverVersion version.Info
+	if err := json.Unmarshal(body, &serverVersion); err != nil {
+		log.Printf("Failed parsing the server version, Err: %v", err)
+		return info, fmt.Errorf("parsing the server version: %w", err)
+	}
+	info.Version = serverVersion.GitVersion
+	info.Platform = serverVersion.Platform
+	log.Printf("Fetched information successfully, Info: %v\n", info)
+	return info, nil
+}
@@ -0,0 +1,90 @@
+package apps
+
+import (
+	"context"
+	"log"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+// ConfigMap represents the information of a config map present in the kubernetes cluster
+type ConfigMap struct {
+	// Name of the config map
+	Name string `json:"name"`
+	// Namespace of the config map
+	Namespace string `json:"namespace"`
+	// Data refers to the key/values held by the config map
+	Data map[string]string `json:"data"`
+	// ResourceVersion refers to the version of the config map, which changes on every update
+	ResourceVersion string `json:"resourceVersion"`
+}
+
+// newConfigMap maps the given kubernetes config map object to the ConfigMap information
+func newConfigMap(info apiv1.ConfigMap) ConfigMap {
+	return ConfigMap{
+		Name:            info.ObjectMeta.Name,
+		Namespace:       info.ObjectMeta.Namespace,
+		Data:            info.Data,
+		ResourceVersion: info.ObjectMeta.ResourceVersion,
+	}
+}
+
+// CreateConfigMap is an API to create a config map identified by "name" holding the given "data" in the given "namespace" and returns
+// the details of the created config map. The AlreadyExists error of the k8s API is passed as is if the config map exists already, so that
+// the callers can use `apierrors.IsAlreadyExists` on it. namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) CreateConfigMap(namespace, name string, data map[string]string, opts ...MutateOption) (*ConfigMap, error) {
+	ctx, cancel := cli.requestContext(context.Background())
+	defer cancel()
+	options := newMutateOptions(opts)
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Creating the config map, Namespace: %s, Name: %s, Dry Run: %v\n", namespace, name, options.dryRun)
+	info := &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Data:       data,
+	}
+	response, err := cli.CoreV1().ConfigMaps(namespace).Create(ctx, info, metav1.CreateOptions{DryRun: options.dryRunValue()})
+	if err != nil {
+		log.Printf("Failed creating the config map, Err: %v", err)
+		return nil, err
+	}
+	configMap := newConfigMap(*response)
+	log.Printf("Created the config map successfully, Name: %s\n", configMap.Name)
+	return &configMap, nil
+}
+
+// UpdateConfigMap is an API to replace the data of the config map identified by "name" in the given "namespace" with the given "data" and
+// returns the details of the updated config map. The metadata (labels, annotations, owners etc.) and the binary data of the config map are preserved.
+// The update is retried on a conflict with a concurrent writer. The NotFound error of the k8s API is passed as is if the config map doesn't exist.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) UpdateConfigMap(namespace, name string, data map[string]string, opts ...MutateOption) (*ConfigMap, error) {
+	ctx, cancel := cli.requestContext(context.Background())
+	defer cancel()
+	options := newMutateOptions(opts)
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Updating the config map, Namespace: %s, Name: %s, Dry Run: %v\n", namespace, name, options.dryRun)
+	var response *apiv1.ConfigMap
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		info, err := cli.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		info.Data = data
+		response, err = cli.CoreV1().ConfigMaps(namespace).Update(ctx, info, metav1.UpdateOptions{DryRun: options.dryRunValue()})
+		return err
+	})
+	if err != nil {
+		log.Printf("Failed updating the config map, Err: %v", err)
+		return nil, err
+	}
+	configMap := newConfigMap(*response)
+	log.Printf("Updated the config map successfully, Name: %s\n", configMap.Name)
+	return &configMap, nil
+}
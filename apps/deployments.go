@@ -0,0 +1,296 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// deploymentProgressDeadlineExceeded refers to the reason of the Progressing condition of a deployment whose rollout is stuck
+const deploymentProgressDeadlineExceeded = "ProgressDeadlineExceeded"
+
+// Deployment represents the information of a deployment present in the kubernetes cluster
+type Deployment struct {
+	// Name of the deployment
+	Name string `json:"name"`
+	// Namespace of the deployment
+	Namespace string `json:"namespace"`
+	// Replicas refers to the desired number of the pods
+	Replicas int `json:"replicas"`
+	// UpdatedReplicas refers to the number of the pods running the latest spec
+	UpdatedReplicas int `json:"updatedReplicas"`
+	// ReadyReplicas refers to the number of the ready pods
+	ReadyReplicas int `json:"readyReplicas"`
+	// AvailableReplicas refers to the number of the pods ready for at least the minimum ready seconds
+	AvailableReplicas int `json:"availableReplicas"`
+}
+
+// newDeployment maps the given kubernetes deployment object to the Deployment information
+func newDeployment(info appsv1.Deployment) Deployment {
+	deployment := Deployment{
+		Name:              info.ObjectMeta.Name,
+		Namespace:         info.ObjectMeta.Namespace,
+		UpdatedReplicas:   int(info.Status.UpdatedReplicas),
+		ReadyReplicas:     int(info.Status.ReadyReplicas),
+		AvailableReplicas: int(info.Status.AvailableReplicas),
+	}
+	// the number of the replicas defaults to 1 when not set
+	deployment.Replicas = 1
+	if info.Spec.Replicas != nil {
+		deployment.Replicas = int(*info.Spec.Replicas)
+	}
+	return deployment
+}
+
+// listDeploymentObjects lists the kubernetes deployment objects present in the given "namespace" (all the namespaces if empty), from the
+// read cache of a client created WithCache once it has synced, from the API otherwise
+func (cli *Client) listDeploymentObjects(ctx context.Context, namespace string) ([]appsv1.Deployment, error) {
+	if deployments, ok := cli.listCachedDeployments(namespace); ok {
+		return deployments, nil
+	}
+	response, err := cli.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Items, nil
+}
+
+// listDeployments lists the deployments present in the given "namespace" (all the namespaces if empty) and maps them to the Deployment information
+func (cli *Client) listDeployments(ctx context.Context, namespace string) ([]Deployment, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	log.Printf("Getting the deployments information, Namespace: %s\n", namespace)
+	deployments, err := listAndMap(func() ([]appsv1.Deployment, error) {
+		return cli.listDeploymentObjects(ctx, namespace)
+	}, newDeployment)
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", deployments)
+	return deployments, nil
+}
+
+// GetDeployments is an API to fetch the details of all the deployments present in a given "namespace".
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetDeployments(namespace string) ([]Deployment, error) {
+	return cli.GetDeploymentsContext(context.Background(), namespace)
+}
+
+// GetDeploymentsContext is the context-aware variant of GetDeployments
+func (cli *Client) GetDeploymentsContext(ctx context.Context, namespace string) ([]Deployment, error) {
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	return cli.listDeployments(ctx, namespace)
+}
+
+// GetDeploymentsAllNamespaces is an API to fetch the details of all the deployments across all the namespaces, each carrying its namespace
+func (cli *Client) GetDeploymentsAllNamespaces() ([]Deployment, error) {
+	return cli.GetDeploymentsAllNamespacesContext(context.Background())
+}
+
+// GetDeploymentsAllNamespacesContext is the context-aware variant of GetDeploymentsAllNamespaces
+func (cli *Client) GetDeploymentsAllNamespacesContext(ctx context.Context) ([]Deployment, error) {
+	return cli.listDeployments(ctx, metav1.NamespaceAll)
+}
+
+// GetDeploymentRolloutStatus is an API to check the rollout of the deployment identified by its "name" in the given "namespace", the same way
+// as `kubectl rollout status` does. It returns whether the rollout is done along with a human readable message ex:
+// "Waiting for deployment "web" rollout to finish: 2 out of 5 new replicas have been updated...".
+// The rollout is done only when the latest spec has been observed and all the replicas are updated and available.
+// An error is returned if the deployment has exceeded its progress deadline. namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetDeploymentRolloutStatus(namespace, name string) (done bool, message string, err error) {
+	ctx, cancel := cli.requestContext(context.Background())
+	defer cancel()
+	namespace, err = cli.resolveNamespace(namespace)
+	if err != nil {
+		return false, "", err
+	}
+	log.Printf("Getting the deployment rollout status, Namespace: %s, Name: %s\n", namespace, name)
+	deployment, err := cli.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return false, "", err
+	}
+	if deployment.Generation > deployment.Status.ObservedGeneration {
+		return false, "Waiting for deployment spec update to be observed...", nil
+	}
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing && condition.Reason == deploymentProgressDeadlineExceeded {
+			return false, "", fmt.Errorf("deployment %q exceeded its progress deadline", name)
+		}
+	}
+	status := deployment.Status
+	if deployment.Spec.Replicas != nil && status.UpdatedReplicas < *deployment.Spec.Replicas {
+		return false, fmt.Sprintf("Waiting for deployment %q rollout to finish: %d out of %d new replicas have been updated...", name, status.UpdatedReplicas, *deployment.Spec.Replicas), nil
+	}
+	if status.Replicas > status.UpdatedReplicas {
+		return false, fmt.Sprintf("Waiting for deployment %q rollout to finish: %d old replicas are pending termination...", name, status.Replicas-status.UpdatedReplicas), nil
+	}
+	if status.AvailableReplicas < status.UpdatedReplicas {
+		return false, fmt.Sprintf("Waiting for deployment %q rollout to finish: %d of %d updated replicas are available...", name, status.AvailableReplicas, status.UpdatedReplicas), nil
+	}
+	return true, fmt.Sprintf("deployment %q successfully rolled out", name), nil
+}
+
+// GetDeploymentPods is an API to fetch the details of the pods selected by the deployment identified by "deploymentName" in the given "namespace".
+// The pods are listed with the label selector of the deployment's spec, i.e. the same pods the deployment considers its own.
+// The NotFound error of the k8s API is passed as is if the deployment doesn't exist.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetDeploymentPods(namespace, deploymentName string) ([]Pod, error) {
+	return cli.GetDeploymentPodsContext(context.Background(), namespace, deploymentName)
+}
+
+// GetDeploymentPodsContext is the context-aware variant of GetDeploymentPods
+func (cli *Client) GetDeploymentPodsContext(ctx context.Context, namespace, deploymentName string) ([]Pod, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the deployment pods information, Namespace: %s, Deployment: %s\n", namespace, deploymentName)
+	deployment, err := cli.AppsV1().Deployments(namespace).Get(ctx, deploymentName, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		log.Printf("Invalid selector of the deployment, Err: %v", err)
+		return nil, fmt.Errorf("invalid selector of deployment %q: %w", deploymentName, err)
+	}
+	pods, err := cli.listPods(ctx, namespace, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", pods)
+	return pods, nil
+}
+
+// getPodSpecImages returns the images referenced by the (init) containers of the given pod spec, deduplicated, the init containers first
+func getPodSpecImages(spec apiv1.PodSpec) []string {
+	var images []string
+	seen := make(map[string]bool)
+	for _, container := range append(append([]apiv1.Container{}, spec.InitContainers...), spec.Containers...) {
+		if !seen[container.Image] {
+			seen[container.Image] = true
+			images = append(images, container.Image)
+		}
+	}
+	return images
+}
+
+// GetDeploymentImages is an API to fetch the images of the deployments present in a given "namespace", keyed by the name of the deployment,
+// ex: to audit which version of each workload is deployed. The images are taken from the pod template of the deployment, the images of
+// the init containers first, without duplicates. namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetDeploymentImages(namespace string) (map[string][]string, error) {
+	return cli.GetDeploymentImagesContext(context.Background(), namespace)
+}
+
+// GetDeploymentImagesContext is the context-aware variant of GetDeploymentImages
+func (cli *Client) GetDeploymentImagesContext(ctx context.Context, namespace string) (map[string][]string, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the deployment images information, Namespace: %s\n", namespace)
+	// deploymentImages holds the images of a single deployment
+	type deploymentImages struct {
+		name   string
+		images []string
+	}
+	deployments, err := listAndMap(func() ([]appsv1.Deployment, error) {
+		return cli.listDeploymentObjects(ctx, namespace)
+	}, func(info appsv1.Deployment) deploymentImages {
+		return deploymentImages{name: info.ObjectMeta.Name, images: getPodSpecImages(info.Spec.Template.Spec)}
+	})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	images := make(map[string][]string, len(deployments))
+	for _, deployment := range deployments {
+		if len(deployment.images) > 0 {
+			images[deployment.name] = deployment.images
+		}
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", images)
+	return images, nil
+}
+
+// DeploymentEvent represents a change of a deployment observed by WatchDeployments, or an error of the watch.
+// The consumers are expected to check Err before using the Deployment.
+type DeploymentEvent struct {
+	// Type of the change ex:"ADDED/MODIFIED/DELETED", "ERROR" along with Err
+	Type watch.EventType `json:"type"`
+	// Deployment refers to the state of the deployment after the change, its last known state for a deletion
+	Deployment Deployment `json:"deployment"`
+	// Err refers to the error of converting the object of the change, or of the watch which is being re-established, nil otherwise
+	Err error `json:"-"`
+}
+
+// WatchDeployments is an API to stream the changes of the deployments present in the given "namespace" from now on, ex: to follow the rollouts live.
+// Every added, modified or deleted deployment is pushed onto the returned channel. The watch is re-established on errors, which are
+// delivered in-band as the events carrying an Err, and the channel is closed once the context is cancelled or the client is closed. namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) WatchDeployments(ctx context.Context, namespace string) (<-chan DeploymentEvent, error) {
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Watching the deployments, Namespace: %s\n", namespace)
+	rw := resourceWatcher{
+		list: func(ctx context.Context) (string, error) {
+			// listing a single item is enough to know the current resource version of the collection
+			response, err := cli.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{Limit: 1})
+			if err != nil {
+				return "", err
+			}
+			return response.ResourceVersion, nil
+		},
+		watch: func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+			return cli.AppsV1().Deployments(namespace).Watch(ctx, opts)
+		},
+	}
+	ctx, cancel := cli.withClientContext(ctx)
+	resourceVersion, err := rw.list(ctx)
+	if err != nil {
+		cancel()
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+
+	events := make(chan DeploymentEvent)
+	send := func(event DeploymentEvent) {
+		select {
+		case events <- event:
+		case <-ctx.Done():
+		}
+	}
+	rw.onError = func(err error) {
+		send(DeploymentEvent{Type: watch.Error, Err: err})
+	}
+	go func() {
+		defer cancel()
+		defer close(events)
+		cli.runWatch(ctx, resourceVersion, rw, func(watchEvent watch.Event) {
+			info, ok := watchEvent.Object.(*appsv1.Deployment)
+			if !ok {
+				send(DeploymentEvent{Type: watchEvent.Type, Err: fmt.Errorf("unexpected object in the deployments watch: %T", watchEvent.Object)})
+				return
+			}
+			send(DeploymentEvent{Type: watchEvent.Type, Deployment: newDeployment(*info)})
+		})
+	}()
+	return events, nil
+}
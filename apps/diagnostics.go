@@ -0,0 +1,412 @@
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// failingPodReadinessGrace refers to the time a running pod is given to become ready before it is reported as failing
+const failingPodReadinessGrace = 5 * time.Minute
+
+// imagePullFailureReasons refers to the waiting reasons of a container whose image can't be pulled
+var imagePullFailureReasons = map[string]bool{
+	"ErrImagePull":     true,
+	"ImagePullBackOff": true,
+	"InvalidImageName": true,
+}
+
+// FailingPod represents a pod showing at least one failure signal along with the explanations of the signals detected
+type FailingPod struct {
+	Pod
+	// Reasons refers to the human readable explanations of the failure signals detected ex:"container "app" is in CrashLoopBackOff"
+	Reasons []string `json:"reasons"`
+}
+
+// ToJSON returns the JSON encoding of the failing pod information, including the reasons of the failure
+func (pod FailingPod) ToJSON() ([]byte, error) {
+	return json.Marshal(pod)
+}
+
+// getFailureReasons returns the explanations of the failure signals shown by the given pod, empty if the pod looks healthy.
+// The signals are the (init) containers in crash loop or failing to pull their image, the containers killed for running out of memory,
+// and a running pod not ready for longer than the grace period.
+func getFailureReasons(pod Pod) []string {
+	var reasons []string
+	containers := append(append([]ContainerStatus{}, pod.InitContainers...), pod.Containers...)
+	for _, container := range containers {
+		switch {
+		case container.Reason == "CrashLoopBackOff":
+			reasons = append(reasons, fmt.Sprintf("container %q is in CrashLoopBackOff", container.Name))
+		case imagePullFailureReasons[container.Reason]:
+			reasons = append(reasons, fmt.Sprintf("container %q can't pull its image: %s", container.Name, container.Reason))
+		}
+		if container.LastTerminationReason == "OOMKilled" {
+			reasons = append(reasons, fmt.Sprintf("container %q was OOMKilled", container.Name))
+		}
+	}
+	if pod.Status == "Running" && !isPodReady(pod) && time.Duration(pod.UpTime)*time.Second > failingPodReadinessGrace {
+		reasons = append(reasons, fmt.Sprintf("pod is not ready for more than %s", FormatAge(failingPodReadinessGrace)))
+	}
+	return reasons
+}
+
+// GetFailingPods is an API to fetch the pods present in a given "namespace" showing any failure signal: a container in CrashLoopBackOff,
+// a container killed for running out of memory (OOMKilled), a container failing to pull its image, or a running pod which hasn't become ready
+// within 5 minutes of its start. Each pod carries the explanations of all the signals detected, a single "what's broken" view.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetFailingPods(namespace string) ([]FailingPod, error) {
+	return cli.GetFailingPodsContext(context.Background(), namespace)
+}
+
+// GetFailingPodsContext is the context-aware variant of GetFailingPods
+func (cli *Client) GetFailingPodsContext(ctx context.Context, namespace string) ([]FailingPod, error) {
+	pods, err := cli.GetPodsWithOptionsContext(ctx, namespace, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var failing []FailingPod
+	for _, pod := range pods {
+		if reasons := getFailureReasons(pod); len(reasons) > 0 {
+			failing = append(failing, FailingPod{Pod: pod, Reasons: reasons})
+		}
+	}
+	log.Printf("Detected the failing pods, Count: %d\n", len(failing))
+	return failing, nil
+}
+
+// ContainerTermination represents the details of the termination of a single (init) container of a pod
+type ContainerTermination struct {
+	// Name of the container
+	Name string `json:"name"`
+	// ExitCode refers to the exit status of the container ex:137 for a container killed by SIGKILL
+	ExitCode int `json:"exitCode"`
+	// Reason refers to the short reason of the termination ex:"OOMKilled/Error/Completed"
+	Reason string `json:"reason"`
+	// Signal refers to the signal which terminated the container, 0 if not reported
+	Signal int `json:"signal"`
+	// StartedAt refers to the time at which the terminated run of the container started
+	StartedAt time.Time `json:"startedAt"`
+	// FinishedAt refers to the time at which the container terminated
+	FinishedAt time.Time `json:"finishedAt"`
+}
+
+// GetPodTerminationInfo is an API to fetch the details of the last termination of the (init) containers of the pod identified by "podName"
+// in the given "namespace", ex: the exit code and the reason telling an OOMKilled container apart from a crashing one. The termination is read
+// from the last termination state of the containers, or from their current state for the containers which are terminated and haven't restarted.
+// The containers which have never terminated are left out. namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetPodTerminationInfo(namespace, podName string) ([]ContainerTermination, error) {
+	ctx, cancel := cli.requestContext(context.Background())
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the pod termination information, Namespace: %s, Name: %s\n", namespace, podName)
+	response, err := cli.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	var terminations []ContainerTermination
+	statuses := append(append([]apiv1.ContainerStatus{}, response.Status.InitContainerStatuses...), response.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		terminated := status.LastTerminationState.Terminated
+		if terminated == nil {
+			terminated = status.State.Terminated
+		}
+		if terminated == nil {
+			continue
+		}
+		terminations = append(terminations, ContainerTermination{
+			Name:       status.Name,
+			ExitCode:   int(terminated.ExitCode),
+			Reason:     terminated.Reason,
+			Signal:     int(terminated.Signal),
+			StartedAt:  terminated.StartedAt.Time,
+			FinishedAt: terminated.FinishedAt.Time,
+		})
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", terminations)
+	return terminations, nil
+}
+
+// describeEnvSource returns the placeholder describing where the value of an environment variable comes from ex: "<fieldRef: metadata.name>"
+// or "<secretKeyRef: db-credentials/password>", the referenced values themselves are never resolved.
+func describeEnvSource(source *apiv1.EnvVarSource) string {
+	switch {
+	case source.FieldRef != nil:
+		return fmt.Sprintf("<fieldRef: %s>", source.FieldRef.FieldPath)
+	case source.ResourceFieldRef != nil:
+		return fmt.Sprintf("<resourceFieldRef: %s>", source.ResourceFieldRef.Resource)
+	case source.ConfigMapKeyRef != nil:
+		return fmt.Sprintf("<configMapKeyRef: %s/%s>", source.ConfigMapKeyRef.Name, source.ConfigMapKeyRef.Key)
+	case source.SecretKeyRef != nil:
+		return fmt.Sprintf("<secretKeyRef: %s/%s>", source.SecretKeyRef.Name, source.SecretKeyRef.Key)
+	}
+	return "<valueFrom>"
+}
+
+// GetPodEnvironment is an API to fetch the environment variables declared for the (init) container identified by "containerName" of the pod
+// identified by "podName" in the given "namespace", as name to value pairs. The literal values are returned as is while the values taken from
+// elsewhere are noted by placeholders ex: "<fieldRef: status.podIP>" or "<secretKeyRef: db-credentials/password>", hence no secret value is exposed.
+// The variables imported as a whole through "envFrom" are not listed. An error is returned if the pod has no such container.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetPodEnvironment(namespace, podName, containerName string) (map[string]string, error) {
+	ctx, cancel := cli.requestContext(context.Background())
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the pod environment, Namespace: %s, Name: %s, Container: %s\n", namespace, podName, containerName)
+	response, err := cli.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	containers := append(append([]apiv1.Container{}, response.Spec.InitContainers...), response.Spec.Containers...)
+	for _, container := range containers {
+		if container.Name != containerName {
+			continue
+		}
+		environment := make(map[string]string, len(container.Env))
+		for _, env := range container.Env {
+			if env.ValueFrom != nil {
+				environment[env.Name] = describeEnvSource(env.ValueFrom)
+			} else {
+				environment[env.Name] = env.Value
+			}
+		}
+		log.Printf("Fetched information successfully, Variables: %d\n", len(environment))
+		return environment, nil
+	}
+	log.Printf("Container not found in the pod, Container: %s\n", containerName)
+	return nil, fmt.Errorf("container %q not found in pod %q", containerName, podName)
+}
+
+// GetStuckReadinessPods is an API to fetch the details of the pods present in a given "namespace" which are Running but not Ready and were
+// started longer than "olderThan" ago, a precise signal of a broken readiness probe. The age is measured from the start time of the pod.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetStuckReadinessPods(namespace string, olderThan time.Duration) ([]Pod, error) {
+	return cli.GetStuckReadinessPodsContext(context.Background(), namespace, olderThan)
+}
+
+// GetStuckReadinessPodsContext is the context-aware variant of GetStuckReadinessPods
+func (cli *Client) GetStuckReadinessPodsContext(ctx context.Context, namespace string, olderThan time.Duration) ([]Pod, error) {
+	return cli.GetPodsFilteredContext(ctx, namespace, func(pod Pod) bool {
+		return pod.Status == string(apiv1.PodRunning) && !isPodReady(pod) && time.Duration(pod.UpTime)*time.Second > olderThan
+	})
+}
+
+// GetImagePullBackoffPods is an API to fetch the details of the pods present in a given "namespace" having an (init) container which can't
+// pull its image, i.e. waiting with the reason "ImagePullBackOff" or "ErrImagePull". The failing image is available in the Image of the
+// container detail. namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetImagePullBackoffPods(namespace string) ([]Pod, error) {
+	return cli.GetImagePullBackoffPodsContext(context.Background(), namespace)
+}
+
+// GetImagePullBackoffPodsContext is the context-aware variant of GetImagePullBackoffPods
+func (cli *Client) GetImagePullBackoffPodsContext(ctx context.Context, namespace string) ([]Pod, error) {
+	return cli.GetPodsFilteredContext(ctx, namespace, func(pod Pod) bool {
+		for _, container := range append(append([]ContainerStatus{}, pod.InitContainers...), pod.Containers...) {
+			if container.Reason == "ImagePullBackOff" || container.Reason == "ErrImagePull" {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// Probe represents the configuration of a liveness/readiness/startup probe of a container
+type Probe struct {
+	// Type of the probe's handler ex:"httpGet/tcpSocket/exec/grpc"
+	Type string `json:"type"`
+	// Path refers to the path requested by an httpGet probe ex:"/healthz"
+	Path string `json:"path,omitempty"`
+	// Port refers to the port (number or name) probed by an httpGet/tcpSocket/grpc probe
+	Port string `json:"port,omitempty"`
+	// Command refers to the command run by an exec probe
+	Command []string `json:"command,omitempty"`
+	// InitialDelaySeconds refers to the delay after the start of the container before the first probe
+	InitialDelaySeconds int `json:"initialDelaySeconds"`
+	// TimeoutSeconds refers to the time after which a probe attempt times out
+	TimeoutSeconds int `json:"timeoutSeconds"`
+	// PeriodSeconds refers to the interval between the probe attempts
+	PeriodSeconds int `json:"periodSeconds"`
+	// SuccessThreshold refers to the consecutive successes after a failure for the probe to be considered successful
+	SuccessThreshold int `json:"successThreshold"`
+	// FailureThreshold refers to the consecutive failures for the probe to be considered failed
+	FailureThreshold int `json:"failureThreshold"`
+}
+
+// Probes represents the probes of a container, a probe is nil when it is not configured
+type Probes struct {
+	// Liveness refers to the probe whose failure restarts the container
+	Liveness *Probe `json:"liveness"`
+	// Readiness refers to the probe whose failure removes the pod from the endpoints of the services
+	Readiness *Probe `json:"readiness"`
+	// Startup refers to the probe which holds off the other probes until the container has started
+	Startup *Probe `json:"startup"`
+}
+
+// newProbe maps the given kubernetes probe to the Probe information, nil if the probe is not configured
+func newProbe(info *apiv1.Probe) *Probe {
+	if info == nil {
+		return nil
+	}
+	probe := &Probe{
+		InitialDelaySeconds: int(info.InitialDelaySeconds),
+		TimeoutSeconds:      int(info.TimeoutSeconds),
+		PeriodSeconds:       int(info.PeriodSeconds),
+		SuccessThreshold:    int(info.SuccessThreshold),
+		FailureThreshold:    int(info.FailureThreshold),
+	}
+	switch {
+	case info.HTTPGet != nil:
+		probe.Type = "httpGet"
+		probe.Path = info.HTTPGet.Path
+		probe.Port = info.HTTPGet.Port.String()
+	case info.TCPSocket != nil:
+		probe.Type = "tcpSocket"
+		probe.Port = info.TCPSocket.Port.String()
+	case info.Exec != nil:
+		probe.Type = "exec"
+		probe.Command = info.Exec.Command
+	case info.GRPC != nil:
+		probe.Type = "grpc"
+		probe.Port = fmt.Sprint(info.GRPC.Port)
+	}
+	return probe
+}
+
+// GetProbes is an API to fetch the configuration of the liveness, readiness and startup probes of the (init) container identified by
+// "containerName" of the pod identified by "podName" in the given "namespace", ex: to spot a timeout too short for a flaky readiness probe.
+// An error is returned if the pod has no such container. namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetProbes(namespace, podName, containerName string) (Probes, error) {
+	ctx, cancel := cli.requestContext(context.Background())
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return Probes{}, err
+	}
+	log.Printf("Getting the container probes, Namespace: %s, Name: %s, Container: %s\n", namespace, podName, containerName)
+	response, err := cli.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return Probes{}, err
+	}
+	containers := append(append([]apiv1.Container{}, response.Spec.InitContainers...), response.Spec.Containers...)
+	for _, container := range containers {
+		if container.Name != containerName {
+			continue
+		}
+		probes := Probes{
+			Liveness:  newProbe(container.LivenessProbe),
+			Readiness: newProbe(container.ReadinessProbe),
+			Startup:   newProbe(container.StartupProbe),
+		}
+		log.Printf("Fetched information successfully, Info: %v\n", probes)
+		return probes, nil
+	}
+	log.Printf("Container not found in the pod, Container: %s\n", containerName)
+	return Probes{}, fmt.Errorf("container %q not found in pod %q", containerName, podName)
+}
+
+// PendingPod represents a pod in the Pending phase along with the reason it isn't scheduled yet
+type PendingPod struct {
+	Pod
+	// Reason refers to the short reason the pod isn't scheduled ex:"Unschedulable", empty if the pod is scheduled or the reason isn't known yet
+	Reason string `json:"reason"`
+	// Message refers to the human readable explanation of the scheduler ex:"0/3 nodes are available: 3 Insufficient cpu."
+	Message string `json:"message"`
+}
+
+// ToJSON returns the JSON encoding of the pending pod information, including the reason it isn't scheduled
+func (pod PendingPod) ToJSON() ([]byte, error) {
+	return json.Marshal(pod)
+}
+
+// GetPendingPods is an API to fetch the details of the pods in the Pending phase present in a given "namespace" along with the reason they
+// aren't scheduled, answering "why isn't this pod scheduling" for a whole namespace at once. The reason is read from the PodScheduled=False
+// condition of the pod and from its most recent FailedScheduling event if the condition isn't set. The pods already scheduled but still
+// Pending, ex: pulling their images, carry an empty Reason. namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetPendingPods(namespace string) ([]PendingPod, error) {
+	return cli.GetPendingPodsContext(context.Background(), namespace)
+}
+
+// GetPendingPodsContext is the context-aware variant of GetPendingPods
+func (cli *Client) GetPendingPodsContext(ctx context.Context, namespace string) ([]PendingPod, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the pending pods information, Namespace: %s\n", namespace)
+	fieldSelector := fields.OneTermEqualSelector("status.phase", string(apiv1.PodPending)).String()
+	response, err := cli.listPodObjects(ctx, namespace, metav1.ListOptions{FieldSelector: fieldSelector})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	var pending []PendingPod
+	// unexplained holds the positions of the unscheduled pods in the pending pods which have no PodScheduled=False condition
+	unexplained := make(map[string]int)
+	for _, info := range response {
+		pod := PendingPod{Pod: newPod(info)}
+		for _, condition := range info.Status.Conditions {
+			if condition.Type == apiv1.PodScheduled && condition.Status == apiv1.ConditionFalse {
+				pod.Reason, pod.Message = condition.Reason, condition.Message
+			}
+		}
+		if pod.Reason == "" && info.Spec.NodeName == "" {
+			unexplained["Pod/"+info.ObjectMeta.Name] = len(pending)
+		}
+		pending = append(pending, pod)
+	}
+	if len(unexplained) > 0 {
+		events, err := cli.getFailedSchedulingEvents(ctx, namespace)
+		if err != nil {
+			log.Printf("Failed getting response from k8s API, Err: %v", err)
+			return nil, err
+		}
+		// the events are sorted oldest first, hence the most recent event of a pod wins
+		for _, event := range events {
+			if index, ok := unexplained[event.InvolvedObject]; ok {
+				pending[index].Reason, pending[index].Message = event.Reason, event.Message
+			}
+		}
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", pending)
+	return pending, nil
+}
+
+// getFailedSchedulingEvents returns the FailedScheduling events recorded in the given "namespace" sorted by their LastTimestamp, oldest first.
+// The events are filtered by the API server with the field selector "reason=FailedScheduling" and on the client side if the server rejects it.
+func (cli *Client) getFailedSchedulingEvents(ctx context.Context, namespace string) ([]Event, error) {
+	fieldSelector := fields.OneTermEqualSelector("reason", "FailedScheduling").String()
+	response, err := cli.listEvents(ctx, namespace, metav1.ListOptions{FieldSelector: fieldSelector})
+	if apierrors.IsBadRequest(err) {
+		log.Printf("Field selector on the event reason is not supported, filtering the events on the client side, Err: %v", err)
+		response, err = cli.listEvents(ctx, namespace, metav1.ListOptions{})
+	}
+	if err != nil {
+		return nil, err
+	}
+	events := filterItems(response, func(event Event) bool {
+		return event.Reason == "FailedScheduling"
+	})
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastTimestamp.Before(events[j].LastTimestamp)
+	})
+	return events, nil
+}
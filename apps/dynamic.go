@@ -0,0 +1,108 @@
+package apps
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+)
+
+// dynamicResource returns the dynamic client of the given resource, scoped to the namespace unless it is an empty string ("")
+func (cli *Client) dynamicResource(gvr schema.GroupVersionResource, namespace string) dynamic.ResourceInterface {
+	if namespace == "" {
+		return cli.dynamicClient.Resource(gvr)
+	}
+	return cli.dynamicClient.Resource(gvr).Namespace(namespace)
+}
+
+// DynamicGet is an API to fetch any object, including the custom resources unknown to this package, identified by its resource "gvr" and "name"
+// ex: schema.GroupVersionResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates"}.
+// Unlike the other APIs, an empty string ("") namespace is not defaulted and refers to a cluster-scoped resource.
+func (cli *Client) DynamicGet(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string) (*unstructured.Unstructured, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	log.Printf("Getting the object, Resource: %s, Namespace: %s, Name: %s\n", gvr.String(), namespace, name)
+	object, err := cli.dynamicResource(gvr, namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	return object, nil
+}
+
+// DynamicList is an API to fetch all the objects of any resource, including the custom resources unknown to this package, identified by its "gvr".
+// Unlike the other APIs, an empty string ("") namespace is not defaulted and lists a cluster-scoped resource (or a namespaced one across all the namespaces).
+func (cli *Client) DynamicList(ctx context.Context, gvr schema.GroupVersionResource, namespace string) (*unstructured.UnstructuredList, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	log.Printf("Getting the objects, Resource: %s, Namespace: %s\n", gvr.String(), namespace)
+	objects, err := cli.dynamicResource(gvr, namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	return objects, nil
+}
+
+// restMapper returns the RESTMapper built from the discovery information of the cluster.
+// The mapper is cached on the client, it is rebuilt only when it is not yet built, is older than the discovery cache TTL or a refresh is requested.
+func (cli *Client) restMapper(refresh bool) (meta.RESTMapper, error) {
+	cli.mapperLock.Lock()
+	defer cli.mapperLock.Unlock()
+	if cli.mapper == nil || refresh || time.Since(cli.mapperBuilt) > cli.discoveryCacheTTL {
+		log.Printf("Building the RESTMapper from the discovery information, Refresh: %v\n", refresh)
+		groupResources, err := restmapper.GetAPIGroupResources(cli.Discovery())
+		if err != nil {
+			log.Printf("Failed getting response from k8s API, Err: %v", err)
+			return nil, err
+		}
+		cli.mapper = restmapper.NewDiscoveryRESTMapper(groupResources)
+		cli.mapperBuilt = time.Now()
+	}
+	return cli.mapper, nil
+}
+
+// mapWithRefresh runs the given mapping function over the cached RESTMapper. When the mapping fails with a NoMatchError the cache is
+// refreshed and the mapping is retried once, as the resource may have been installed (ex: a new CRD) after the cache was built.
+func (cli *Client) mapWithRefresh(mapping func(mapper meta.RESTMapper) error) error {
+	mapper, err := cli.restMapper(false)
+	if err != nil {
+		return err
+	}
+	err = mapping(mapper)
+	if !meta.IsNoMatchError(err) {
+		return err
+	}
+	mapper, err = cli.restMapper(true)
+	if err != nil {
+		return err
+	}
+	return mapping(mapper)
+}
+
+// ResolveGVR is an API to resolve a human "kind" ex:"Deployment" to the preferred group/version/resource served by the cluster,
+// which can be used with DynamicGet/DynamicList. The kind can be qualified by its group to avoid ambiguity ex:"Certificate.cert-manager.io".
+// The discovery information is cached on the client and refreshed when the kind is not found, to handle the newly installed CRDs.
+func (cli *Client) ResolveGVR(kind string) (schema.GroupVersionResource, error) {
+	groupKind := schema.ParseGroupKind(kind)
+	// the mapper registers the lower-cased kind as the singular name of each resource
+	partial := schema.GroupVersionResource{Group: groupKind.Group, Resource: strings.ToLower(groupKind.Kind)}
+	var gvr schema.GroupVersionResource
+	err := cli.mapWithRefresh(func(mapper meta.RESTMapper) error {
+		var err error
+		gvr, err = mapper.ResourceFor(partial)
+		return err
+	})
+	if err != nil {
+		log.Printf("Failed resolving the kind: %s, Err: %v", kind, err)
+		return schema.GroupVersionResource{}, err
+	}
+	return gvr, nil
+}
@@ -0,0 +1,92 @@
+package apps
+
+import (
+	"context"
+	"testing"
+
+	apiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newFakeClient returns a client backed by a fake clientset seeded with the given objects
+func newFakeClient(t *testing.T, objects ...runtime.Object) (*Client, *fake.Clientset) {
+	t.Helper()
+	clientset := fake.NewClientset(objects...)
+	cli := &Client{
+		Interface:      clientset,
+		config:         &rest.Config{},
+		defaultTimeout: defaultRequestTimeout,
+		namespace:      defaultNamespace,
+	}
+	cli.ctx, cli.cancel = context.WithCancel(context.Background())
+	t.Cleanup(cli.cancel)
+	return cli, clientset
+}
+
+// TestAPIErrorsArePreserved checks that the errors injected into the fake clientset reach the callers in a shape
+// the checks of "k8s.io/apimachinery/pkg/api/errors" recognize
+func TestAPIErrorsArePreserved(t *testing.T) {
+	configMap := &apiv1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "default"}}
+	tests := []struct {
+		name     string
+		verb     string
+		resource string
+		err      error
+		call     func(cli *Client) error
+		check    func(err error) bool
+	}{
+		{
+			name:     "GetPod NotFound",
+			verb:     "get",
+			resource: "pods",
+			err:      apierrors.NewNotFound(schema.GroupResource{Resource: "pods"}, "web-0"),
+			call: func(cli *Client) error {
+				_, err := cli.GetPod("default", "web-0")
+				return err
+			},
+			check: apierrors.IsNotFound,
+		},
+		{
+			name:     "LabelPod Forbidden",
+			verb:     "patch",
+			resource: "pods",
+			err:      apierrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "web-0", nil),
+			call: func(cli *Client) error {
+				return cli.LabelPod("default", "web-0", map[string]string{"tier": "web"})
+			},
+			check: apierrors.IsForbidden,
+		},
+		{
+			name:     "UpdateConfigMap Conflict",
+			verb:     "update",
+			resource: "configmaps",
+			err:      apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, "settings", nil),
+			call: func(cli *Client) error {
+				_, err := cli.UpdateConfigMap("default", "settings", map[string]string{"key": "value"})
+				return err
+			},
+			check: apierrors.IsConflict,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cli, clientset := newFakeClient(t, configMap.DeepCopy())
+			clientset.PrependReactor(test.verb, test.resource, func(k8stesting.Action) (bool, runtime.Object, error) {
+				return true, nil, test.err
+			})
+			err := test.call(cli)
+			if err == nil {
+				t.Fatalf("expected an error, got nil")
+			}
+			if !test.check(err) {
+				t.Errorf("the error is not recognized by the apierrors check, Err: %v", err)
+			}
+		})
+	}
+}
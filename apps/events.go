@@ -0,0 +1,235 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// Event represents the information of an event recorded in the kubernetes cluster
+type Event struct {
+	// Name of the event
+	Name string `json:"name"`
+	// Namespace of the event
+	Namespace string `json:"namespace"`
+	// Type of the event ex:"Normal/Warning"
+	Type string `json:"type"`
+	// Reason refers to the short, machine understandable reason of the event ex:"FailedScheduling/BackOff" etc.
+	Reason string `json:"reason"`
+	// Message refers to the human readable description of the event
+	Message string `json:"message"`
+	// InvolvedObject refers to the object the event is about in the "<kind>/<name>" format ex:"Pod/web-0"
+	InvolvedObject string `json:"involvedObject"`
+	// Count refers to the number of times the event has occurred
+	Count int `json:"count"`
+	// FirstTimestamp refers to the time at which the event was first recorded
+	FirstTimestamp time.Time `json:"firstTimestamp"`
+	// LastTimestamp refers to the time at which the most recent occurrence of the event was recorded
+	LastTimestamp time.Time `json:"lastTimestamp"`
+}
+
+// newEvent maps the given kubernetes event object to the Event information.
+// The timestamps fall back to the event time for the events which are recorded through the newer events API.
+func newEvent(info apiv1.Event) Event {
+	event := Event{
+		Name:           info.ObjectMeta.Name,
+		Namespace:      info.ObjectMeta.Namespace,
+		Type:           info.Type,
+		Reason:         info.Reason,
+		Message:        info.Message,
+		InvolvedObject: info.InvolvedObject.Kind + "/" + info.InvolvedObject.Name,
+		Count:          int(info.Count),
+		FirstTimestamp: info.FirstTimestamp.Time,
+		LastTimestamp:  info.LastTimestamp.Time,
+	}
+	if event.FirstTimestamp.IsZero() {
+		event.FirstTimestamp = info.EventTime.Time
+	}
+	if event.LastTimestamp.IsZero() {
+		event.LastTimestamp = event.FirstTimestamp
+	}
+	if info.Series != nil && info.Series.LastObservedTime.Time.After(event.LastTimestamp) {
+		event.LastTimestamp = info.Series.LastObservedTime.Time
+	}
+	return event
+}
+
+// listEvents lists the events in the given "namespace" with the given list options and maps them to the Event information
+func (cli *Client) listEvents(ctx context.Context, namespace string, opts metav1.ListOptions) ([]Event, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	return listAndMap(func() ([]apiv1.Event, error) {
+		response, err := cli.CoreV1().Events(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return response.Items, nil
+	}, newEvent)
+}
+
+// GetEventsSince is an API to fetch the events recorded in the given "namespace" whose last occurrence falls within the "since" window, i.e.
+// the events with a LastTimestamp after now minus "since". The filtering is done on the client side since the events API doesn't reliably
+// support field selectors on the timestamps. The events are sorted by their LastTimestamp, oldest first.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetEventsSince(namespace string, since time.Duration) ([]Event, error) {
+	return cli.GetEventsSinceContext(context.Background(), namespace, since)
+}
+
+// GetEventsSinceContext is the context-aware variant of GetEventsSince
+func (cli *Client) GetEventsSinceContext(ctx context.Context, namespace string, since time.Duration) ([]Event, error) {
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the events information, Namespace: %s, Since: %v\n", namespace, since)
+	response, err := cli.listEvents(ctx, namespace, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	cutoff := time.Now().Add(-since)
+	events := filterItems(response, func(event Event) bool {
+		return event.LastTimestamp.After(cutoff)
+	})
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastTimestamp.Before(events[j].LastTimestamp)
+	})
+	log.Printf("Fetched information successfully, Info: %v\n", events)
+	return events, nil
+}
+
+// GetEventsTimeline is an API to fetch the events recorded in the given "namespace" grouped by the object they are about, keyed by the
+// "<kind>/<name>" of the involved object ex:"Pod/web-0", each group sorted by the LastTimestamp of the events, oldest first. This tells
+// the full sequence of the events that happened to a single object. namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetEventsTimeline(namespace string) (map[string][]Event, error) {
+	return cli.GetEventsTimelineContext(context.Background(), namespace)
+}
+
+// GetEventsTimelineContext is the context-aware variant of GetEventsTimeline
+func (cli *Client) GetEventsTimelineContext(ctx context.Context, namespace string) (map[string][]Event, error) {
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the events timeline, Namespace: %s\n", namespace)
+	events, err := cli.listEvents(ctx, namespace, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	timeline := make(map[string][]Event)
+	for _, event := range events {
+		timeline[event.InvolvedObject] = append(timeline[event.InvolvedObject], event)
+	}
+	for _, objectEvents := range timeline {
+		sort.SliceStable(objectEvents, func(i, j int) bool {
+			return objectEvents[i].LastTimestamp.Before(objectEvents[j].LastTimestamp)
+		})
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", timeline)
+	return timeline, nil
+}
+
+// GetWarningEvents is an API to fetch only the events of the "Warning" type recorded in the given "namespace", sorted by their count
+// so that the noisiest warnings come first. The events are filtered by the API server with the field selector "type=Warning" and
+// on the client side if the server rejects the field selector. namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetWarningEvents(ctx context.Context, namespace string) ([]Event, error) {
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the warning events information, Namespace: %s\n", namespace)
+	fieldSelector := fields.OneTermEqualSelector("type", apiv1.EventTypeWarning).String()
+	response, err := cli.listEvents(ctx, namespace, metav1.ListOptions{FieldSelector: fieldSelector})
+	if apierrors.IsBadRequest(err) {
+		log.Printf("Field selector on the event type is not supported, filtering the events on the client side, Err: %v", err)
+		response, err = cli.listEvents(ctx, namespace, metav1.ListOptions{})
+	}
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	events := filterItems(response, func(event Event) bool {
+		return event.Type == apiv1.EventTypeWarning
+	})
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Count > events[j].Count
+	})
+	log.Printf("Fetched information successfully, Info: %v\n", events)
+	return events, nil
+}
+
+// EventWatchEvent represents a change of an event observed by WatchEvents, or an error of the watch.
+// The consumers are expected to check Err before using the Event.
+type EventWatchEvent struct {
+	// Type of the change ex:"ADDED/MODIFIED/DELETED", "ERROR" along with Err
+	Type watch.EventType `json:"type"`
+	// Event refers to the state of the event after the change, its last known state for a deletion
+	Event Event `json:"event"`
+	// Err refers to the error of converting the object of the change, or of the watch which is being re-established, nil otherwise
+	Err error `json:"-"`
+}
+
+// WatchEvents is an API to stream the events recorded in the given "namespace" from now on.
+// Every added, modified or deleted event is pushed onto the returned channel. The watch is re-established on errors, which are
+// delivered in-band as the events carrying an Err, and the channel is closed once the context is cancelled or the client is closed.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) WatchEvents(ctx context.Context, namespace string) (<-chan EventWatchEvent, error) {
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Watching the events, Namespace: %s\n", namespace)
+	rw := resourceWatcher{
+		list: func(ctx context.Context) (string, error) {
+			// listing a single item is enough to know the current resource version of the collection
+			response, err := cli.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{Limit: 1})
+			if err != nil {
+				return "", err
+			}
+			return response.ResourceVersion, nil
+		},
+		watch: func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+			return cli.CoreV1().Events(namespace).Watch(ctx, opts)
+		},
+	}
+	ctx, cancel := cli.withClientContext(ctx)
+	resourceVersion, err := rw.list(ctx)
+	if err != nil {
+		cancel()
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+
+	events := make(chan EventWatchEvent)
+	send := func(event EventWatchEvent) {
+		select {
+		case events <- event:
+		case <-ctx.Done():
+		}
+	}
+	rw.onError = func(err error) {
+		send(EventWatchEvent{Type: watch.Error, Err: err})
+	}
+	go func() {
+		defer cancel()
+		defer close(events)
+		cli.runWatch(ctx, resourceVersion, rw, func(watchEvent watch.Event) {
+			info, ok := watchEvent.Object.(*apiv1.Event)
+			if !ok {
+				send(EventWatchEvent{Type: watchEvent.Type, Err: fmt.Errorf("unexpected object in the events watch: %T", watchEvent.Object)})
+				return
+			}
+			send(EventWatchEvent{Type: watchEvent.Type, Event: newEvent(*info)})
+		})
+	}()
+	return events, nil
+}
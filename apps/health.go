@@ -0,0 +1,113 @@
+package apps
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ComponentStatus represents the health of a control plane component ex:"etcd/scheduler/controller-manager"
+type ComponentStatus struct {
+	// Name of the component
+	Name string `json:"name"`
+	// Healthy represents if the component is healthy
+	Healthy bool `json:"healthy"`
+	// Message refers to the message/error reported by the health check of the component
+	Message string `json:"message"`
+}
+
+// controlPlaneHealthChecks refers to the health endpoints of the API server probed when the component statuses are not available, keyed by the component name
+var controlPlaneHealthChecks = map[string]string{
+	"etcd":      "/healthz/etcd",
+	"apiserver": "/livez",
+}
+
+// newComponentStatus maps the given kubernetes component status to the ComponentStatus information, derived from its Healthy condition
+func newComponentStatus(info apiv1.ComponentStatus) ComponentStatus {
+	status := ComponentStatus{Name: info.ObjectMeta.Name}
+	for _, condition := range info.Conditions {
+		if condition.Type != apiv1.ComponentHealthy {
+			continue
+		}
+		status.Healthy = condition.Status == apiv1.ConditionTrue
+		status.Message = condition.Message
+		if condition.Error != "" {
+			status.Message = condition.Error
+		}
+	}
+	return status
+}
+
+// GetComponentStatuses is an API to fetch the health of the control plane components.
+// The statuses are derived from the conditions of the "componentstatuses" API. That API is deprecated and may be empty/unavailable on the
+// newer clusters, in which case the health endpoints of the API server ("/healthz/etcd" and "/livez") are probed instead and reported as
+// the "etcd" and "apiserver" components respectively. A failing probe is reported as an unhealthy component rather than as an error,
+// whereas the other failures of the API (ex: Forbidden) and the cancellation of the context are returned as errors.
+func (cli *Client) GetComponentStatuses() ([]ComponentStatus, error) {
+	return cli.GetComponentStatusesContext(context.Background())
+}
+
+// GetComponentStatusesContext is the context-aware variant of GetComponentStatuses
+func (cli *Client) GetComponentStatusesContext(ctx context.Context) ([]ComponentStatus, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	log.Printf("Getting the component statuses information\n")
+	statuses, err := listAndMap(func() ([]apiv1.ComponentStatus, error) {
+		response, err := cli.CoreV1().ComponentStatuses().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return response.Items, nil
+	}, newComponentStatus)
+	if apierrors.IsNotFound(err) || (err == nil && len(statuses) == 0) {
+		log.Printf("Component statuses are not available, probing the health endpoints instead, Err: %v", err)
+		statuses = cli.probeControlPlane(ctx)
+		// a probe cut short by the context is not a sign of an unhealthy component
+		if err := ctx.Err(); err != nil {
+			log.Printf("Failed probing the health endpoints, Err: %v", err)
+			return nil, err
+		}
+		return statuses, nil
+	}
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", statuses)
+	return statuses, nil
+}
+
+// probeControlPlane reports the health of the control plane components by probing the health endpoints of the API server
+func (cli *Client) probeControlPlane(ctx context.Context) []ComponentStatus {
+	var statuses []ComponentStatus
+	for _, name := range []string{"etcd", "apiserver"} {
+		status := ComponentStatus{Name: name, Healthy: true}
+		body, err := cli.Discovery().RESTClient().Get().AbsPath(controlPlaneHealthChecks[name]).DoRaw(ctx)
+		status.Message = strings.TrimSpace(string(body))
+		if err != nil {
+			status.Healthy = false
+			status.Message = err.Error()
+		}
+		statuses = append(statuses, status)
+	}
+	log.Printf("Probed the health endpoints successfully, Info: %v\n", statuses)
+	return statuses
+}
+
+// isPodReady returns whether the pod is Running with its Ready condition True, the same readiness as counted by GetReadinessSummary
+func isPodReady(pod Pod) bool {
+	return pod.Status == string(apiv1.PodRunning) && pod.Ready
+}
+
+// GetKubeSystemHealth is an API to fetch the details of the pods in the "kube-system" namespace which are not Running and Ready, i.e. the
+// broken core components such as CoreDNS, kube-proxy or the CNI. The pods which have run to completion ("Succeeded") are not considered broken.
+// An empty result means the core components are healthy.
+func (cli *Client) GetKubeSystemHealth(ctx context.Context) ([]Pod, error) {
+	return cli.GetPodsFilteredContext(ctx, metav1.NamespaceSystem, func(pod Pod) bool {
+		return pod.Status != string(apiv1.PodSucceeded) && !isPodReady(pod)
+	})
+}
@@ -0,0 +1,48 @@
+package apps
+
+import (
+	"context"
+	"testing"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// newRunningPod returns a Running pod in the given namespace whose Ready condition is set to "ready", without any condition if nil
+func newRunningPod(namespace, name string, ready *bool) *apiv1.Pod {
+	pod := &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Status:     apiv1.PodStatus{Phase: apiv1.PodRunning},
+	}
+	if ready != nil {
+		status := apiv1.ConditionFalse
+		if *ready {
+			status = apiv1.ConditionTrue
+		}
+		pod.Status.Conditions = []apiv1.PodCondition{{Type: apiv1.PodReady, Status: status}}
+	}
+	return pod
+}
+
+// TestGetKubeSystemHealth checks that the readiness of the kube-system pods is decided by their Ready condition
+func TestGetKubeSystemHealth(t *testing.T) {
+	ready, notReady := true, false
+	cli, _ := newFakeClient(t,
+		newRunningPod(metav1.NamespaceSystem, "coredns", &ready),
+		// a Running pod without any container status or condition must not be reported healthy
+		newRunningPod(metav1.NamespaceSystem, "kube-proxy", nil),
+		// a pod whose readiness gate is failing has its Ready condition False though its containers are ready
+		newRunningPod(metav1.NamespaceSystem, "cilium", &notReady),
+	)
+	pods, err := cli.GetKubeSystemHealth(context.Background())
+	if err != nil {
+		t.Fatalf("getting the kube-system health failed, Err: %v", err)
+	}
+	broken := make(map[string]bool)
+	for _, pod := range pods {
+		broken[pod.Name] = true
+	}
+	if len(broken) != 2 || !broken["kube-proxy"] || !broken["cilium"] {
+		t.Errorf("expected kube-proxy and cilium to be broken, got %v", broken)
+	}
+}
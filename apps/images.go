@@ -0,0 +1,81 @@
+package apps
+
+import (
+	"context"
+	"log"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ImageUsage represents a container image running in the kubernetes cluster along with the pods using it
+type ImageUsage struct {
+	// Image refers to the image reference as specified in the pod ex:"nginx:1.25"
+	Image string `json:"image"`
+	// ImageID refers to the resolved image (digest) as reported by the container runtime, the first one seen if the reference resolved to many
+	ImageID string `json:"imageID"`
+	// Pods refers to the names of the pods running a container (or an init container) of the image
+	Pods []string `json:"pods"`
+}
+
+// newPodImageUsages maps the given kubernetes pod to the usages of the images run by its (init) containers, each listing the pod once
+func newPodImageUsages(info apiv1.Pod) []ImageUsage {
+	var usages []ImageUsage
+	// indexes holds the position of each image reference in the usages, a pod is listed once against an image used by many of its containers
+	indexes := make(map[string]int)
+	for _, status := range append(append([]apiv1.ContainerStatus{}, info.Status.InitContainerStatuses...), info.Status.ContainerStatuses...) {
+		index, ok := indexes[status.Image]
+		if !ok {
+			index = len(usages)
+			indexes[status.Image] = index
+			usages = append(usages, ImageUsage{Image: status.Image, Pods: []string{info.ObjectMeta.Name}})
+		}
+		if usages[index].ImageID == "" {
+			usages[index].ImageID = status.ImageID
+		}
+	}
+	return usages
+}
+
+// GetContainerImages is an API to fetch the inventory of the images run by the containers and init containers of the pods present in a given "namespace".
+// The images are deduplicated by their reference. namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetContainerImages(namespace string) ([]ImageUsage, error) {
+	return cli.GetContainerImagesContext(context.Background(), namespace)
+}
+
+// GetContainerImagesContext is the context-aware variant of GetContainerImages
+func (cli *Client) GetContainerImagesContext(ctx context.Context, namespace string) ([]ImageUsage, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the container images information, Namespace: %s\n", namespace)
+	podImages, err := listAndMap(func() ([]apiv1.Pod, error) {
+		return cli.listPodObjects(ctx, namespace, metav1.ListOptions{})
+	}, newPodImageUsages)
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	var images []ImageUsage
+	// indexes holds the position of each image reference in the images
+	indexes := make(map[string]int)
+	for _, usages := range podImages {
+		for _, usage := range usages {
+			index, ok := indexes[usage.Image]
+			if !ok {
+				index = len(images)
+				indexes[usage.Image] = index
+				images = append(images, ImageUsage{Image: usage.Image})
+			}
+			if images[index].ImageID == "" {
+				images[index].ImageID = usage.ImageID
+			}
+			images[index].Pods = append(images[index].Pods, usage.Pods...)
+		}
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", images)
+	return images, nil
+}
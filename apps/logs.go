@@ -0,0 +1,136 @@
+package apps
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ErrNoPreviousContainer is returned when the logs of the previous instance are requested for a container which has not been restarted
+var ErrNoPreviousContainer = errors.New("no previous terminated instance of the container")
+
+// getPodLogs fetches the logs of the pod's container as per the given log options.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) getPodLogs(namespace, podName string, opts *apiv1.PodLogOptions) (string, error) {
+	ctx, cancel := cli.requestContext(context.Background())
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return "", err
+	}
+	log.Printf("Getting the pod logs, Namespace: %s, Pod: %s, Container: %s\n", namespace, podName, opts.Container)
+	logs, err := cli.CoreV1().Pods(namespace).GetLogs(podName, opts).DoRaw(ctx)
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return "", err
+	}
+	return string(logs), nil
+}
+
+// GetPodLogs is an API to fetch the logs of the container identified by "containerName" of the given pod.
+// containerName can be an empty string ("") for a pod having a single container, in which case that container is selected by the API server.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetPodLogs(namespace, podName, containerName string) (string, error) {
+	return cli.getPodLogs(namespace, podName, &apiv1.PodLogOptions{Container: containerName})
+}
+
+// GetPodLogsSince is an API to fetch the logs written since the given time by the container identified by "containerName" of the given pod.
+// The container selection and the namespace defaulting behave the same as GetPodLogs.
+func (cli *Client) GetPodLogsSince(namespace, podName, containerName string, since time.Time) (string, error) {
+	sinceTime := metav1.NewTime(since)
+	return cli.getPodLogs(namespace, podName, &apiv1.PodLogOptions{Container: containerName, SinceTime: &sinceTime})
+}
+
+// GetPreviousPodLogs is an API to fetch the logs of the previous (crashed/terminated) instance of the container identified by "containerName" of the given pod.
+// An error wrapping ErrNoPreviousContainer is returned when the container has no previous instance, check it with `errors.Is`.
+// The container selection and the namespace defaulting behave the same as GetPodLogs.
+func (cli *Client) GetPreviousPodLogs(namespace, podName, containerName string) (string, error) {
+	logs, err := cli.getPodLogs(namespace, podName, &apiv1.PodLogOptions{Container: containerName, Previous: true})
+	// the API server rejects the request with a "previous terminated container ... not found" message
+	if apierrors.IsBadRequest(err) && strings.Contains(err.Error(), "previous terminated container") {
+		return "", fmt.Errorf("%w: %w", ErrNoPreviousContainer, err)
+	}
+	return logs, err
+}
+
+// StreamAllContainerLogs is an API to follow the logs of all the containers of the pod identified by "podName" in the given "namespace" at once,
+// writing the lines of every container, interleaved as they arrive, into "w" prefixed by the container name ex: "[sidecar] listening on :8080".
+// It returns once all the streams have ended, ex: when the pod terminates, or the context is done (ctx.Err() is returned) or the client is closed
+// (ErrClientClosed is returned), which stops all the streams. The errors of the individual streams are joined. namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) StreamAllContainerLogs(ctx context.Context, namespace, podName string, w io.Writer) error {
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return err
+	}
+	log.Printf("Streaming the logs of all the containers, Namespace: %s, Pod: %s\n", namespace, podName)
+	requestCtx, cancel := cli.requestContext(ctx)
+	pod, err := cli.CoreV1().Pods(namespace).Get(requestCtx, podName, metav1.GetOptions{})
+	cancel()
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return err
+	}
+
+	streamCtx, cancel := cli.withClientContext(ctx)
+	defer cancel()
+	var errs []error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, container := range pod.Spec.Containers {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			err := cli.streamContainerLogs(streamCtx, namespace, podName, name, w, &mu)
+			if err != nil && streamCtx.Err() == nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("streaming the logs of container %q: %w", name, err))
+				mu.Unlock()
+			}
+		}(container.Name)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if cli.ctx.Err() != nil {
+		return ErrClientClosed
+	}
+	err = errors.Join(errs...)
+	if err != nil {
+		log.Printf("Failed streaming the logs of a few containers, Err: %v", err)
+	}
+	return err
+}
+
+// streamContainerLogs follows the logs of the given container and writes them line by line into "w", prefixed by the container name.
+// The writes are serialized by "mu" as "w" is shared by the streams of all the containers.
+func (cli *Client) streamContainerLogs(ctx context.Context, namespace, podName, containerName string, w io.Writer, mu *sync.Mutex) error {
+	stream, err := cli.CoreV1().Pods(namespace).GetLogs(podName, &apiv1.PodLogOptions{Container: containerName, Follow: true}).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	scanner := bufio.NewScanner(stream)
+	// a single log line can be much longer than the default limit of the scanner
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	prefix := "[" + containerName + "] "
+	for scanner.Scan() {
+		mu.Lock()
+		_, err := io.WriteString(w, prefix+scanner.Text()+"\n")
+		mu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,102 @@
+package apps
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// apiMetrics holds the Prometheus collectors recording the calls made by the client to the Kubernetes API
+type apiMetrics struct {
+	// requests counts the calls by their verb, resource and result ("success/error")
+	requests *prometheus.CounterVec
+	// duration observes the latency of the calls by their verb and resource
+	duration *prometheus.HistogramVec
+}
+
+// newAPIMetrics creates the collectors of the API calls and registers them with the given registry
+func newAPIMetrics(registry *prometheus.Registry) (*apiMetrics, error) {
+	metrics := &apiMetrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "apps_client",
+			Name:      "api_requests_total",
+			Help:      "Number of the calls made to the Kubernetes API by verb, resource and result.",
+		}, []string{"verb", "resource", "result"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "apps_client",
+			Name:      "api_request_duration_seconds",
+			Help:      "Latency of the calls made to the Kubernetes API by verb and resource.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"verb", "resource"}),
+	}
+	for _, collector := range []prometheus.Collector{metrics.requests, metrics.duration} {
+		if err := registry.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+	return metrics, nil
+}
+
+// metricsTransport records every request to the Kubernetes API passing through it in the apiMetrics
+type metricsTransport struct {
+	metrics *apiMetrics
+	next    http.RoundTripper
+}
+
+// RoundTrip implements the http.RoundTripper interface
+func (t *metricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	verb, resource := requestVerbAndResource(req)
+	started := time.Now()
+	response, err := t.next.RoundTrip(req)
+	t.metrics.duration.WithLabelValues(verb, resource).Observe(time.Since(started).Seconds())
+	result := "success"
+	if err != nil || response.StatusCode >= http.StatusBadRequest {
+		result = "error"
+	}
+	t.metrics.requests.WithLabelValues(verb, resource, result).Inc()
+	return response, err
+}
+
+// requestVerbAndResource derives the kubernetes verb (get/list/watch/create etc.) and the resource (pods/deployments etc.) of the
+// request from its method and its path ex: "/api/v1/namespaces/default/pods/web-0" or "/apis/apps/v1/deployments".
+// The resource is "other" for the paths which don't refer to a resource, such as "/healthz" or "/version".
+func requestVerbAndResource(req *http.Request) (string, string) {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	switch {
+	case len(segments) > 2 && segments[0] == "api":
+		segments = segments[2:]
+	case len(segments) > 3 && segments[0] == "apis":
+		segments = segments[3:]
+	default:
+		segments = nil
+	}
+	if len(segments) > 2 && segments[0] == "namespaces" {
+		segments = segments[2:]
+	}
+	resource := "other"
+	if len(segments) > 0 {
+		resource = segments[0]
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		if req.URL.Query().Get("watch") == "true" {
+			return "watch", resource
+		}
+		if len(segments) > 1 {
+			return "get", resource
+		}
+		return "list", resource
+	case http.MethodPost:
+		return "create", resource
+	case http.MethodPut:
+		return "update", resource
+	case http.MethodPatch:
+		return "patch", resource
+	case http.MethodDelete:
+		return "delete", resource
+	}
+	return strings.ToLower(req.Method), resource
+}
@@ -0,0 +1,37 @@
+package apps
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+// TestRequestVerbAndResource checks that the verb and the resource of the requests are derived from their method and path
+func TestRequestVerbAndResource(t *testing.T) {
+	tests := []struct {
+		method   string
+		target   string
+		verb     string
+		resource string
+	}{
+		{method: "GET", target: "/api/v1/namespaces/default/pods", verb: "list", resource: "pods"},
+		{method: "GET", target: "/api/v1/namespaces/default/pods/web-0", verb: "get", resource: "pods"},
+		{method: "GET", target: "/api/v1/namespaces/default/pods/web-0/log", verb: "get", resource: "pods"},
+		{method: "GET", target: "/api/v1/namespaces/default/pods?watch=true", verb: "watch", resource: "pods"},
+		{method: "GET", target: "/api/v1/namespaces", verb: "list", resource: "namespaces"},
+		{method: "GET", target: "/api/v1/namespaces/default", verb: "get", resource: "namespaces"},
+		{method: "GET", target: "/apis/apps/v1/deployments", verb: "list", resource: "deployments"},
+		{method: "POST", target: "/apis/apps/v1/namespaces/default/deployments", verb: "create", resource: "deployments"},
+		{method: "PUT", target: "/apis/apps/v1/namespaces/default/deployments/web", verb: "update", resource: "deployments"},
+		{method: "PATCH", target: "/apis/apps/v1/namespaces/default/deployments/web", verb: "patch", resource: "deployments"},
+		{method: "DELETE", target: "/api/v1/namespaces/default/pods/web-0", verb: "delete", resource: "pods"},
+		{method: "GET", target: "/healthz", verb: "list", resource: "other"},
+		{method: "HEAD", target: "/version", verb: "head", resource: "other"},
+	}
+	for _, test := range tests {
+		req := httptest.NewRequest(test.method, test.target, nil)
+		verb, resource := requestVerbAndResource(req)
+		if verb != test.verb || resource != test.resource {
+			t.Errorf("expected %s %s to be %s/%s, got: %s/%s", test.method, test.target, test.verb, test.resource, verb, resource)
+		}
+	}
+}
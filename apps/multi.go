@@ -0,0 +1,91 @@
+package apps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// MultiClient holds a client for each of several clusters, keyed by the name of the cluster, to fan the queries out across a fleet
+type MultiClient struct {
+	// clients refers to the clients of the clusters keyed by their name, i.e. the name of their kubeconfig context
+	clients map[string]*Client
+}
+
+// NewMultiClient is a constructor function which initializes a client for each of the given kubeconfig "contexts", named after the context.
+// The kubeconfig is loaded the same way as kubectl does, i.e. from the KUBECONFIG environment variable or "~/.kube/config".
+// The functional options apply to every client, hence WithMetricsRegistry can't be shared across the clients since the collectors can only be
+// registered once with a registry, create the clients with NewClient and a registry each instead. An error is returned, and the clients created so far are closed, if any client can't be created.
+func NewMultiClient(contexts []string, opts ...Option) (*MultiClient, error) {
+	log.Printf("Initializing the clients of the clusters, Contexts: %v\n", contexts)
+	multi := &MultiClient{clients: make(map[string]*Client, len(contexts))}
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	for _, name := range contexts {
+		overrides := &clientcmd.ConfigOverrides{CurrentContext: name}
+		config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides).ClientConfig()
+		var cli *Client
+		if err == nil {
+			cli, err = newClientForConfig(config, opts...)
+		}
+		if err != nil {
+			log.Printf("Creating the client of the cluster failed, Context: %s, Error: %v\n", name, err)
+			multi.Close()
+			return nil, fmt.Errorf("creating the client of context %q: %w", name, err)
+		}
+		multi.clients[name] = cli
+	}
+	return multi, nil
+}
+
+// Client returns the client of the cluster identified by its "name", nil if there is no such cluster
+func (multi *MultiClient) Client(name string) *Client {
+	return multi.clients[name]
+}
+
+// Close closes the clients of all the clusters
+func (multi *MultiClient) Close() error {
+	for _, cli := range multi.clients {
+		if cli == nil {
+			continue
+		}
+		cli.Close()
+	}
+	return nil
+}
+
+// GetPodsAll is an API to fetch the details of all the pods present in a given "namespace" of every cluster, keyed by the name of the cluster.
+// The clusters are queried concurrently. The errors of the individual clusters are joined and returned along with the pods of the clusters
+// that succeeded. namespace defaults to the default namespace of each client if the argument passed is an empty string ("")
+func (multi *MultiClient) GetPodsAll(ctx context.Context, namespace string) (map[string][]Pod, error) {
+	log.Printf("Getting the pods information of all the clusters, Namespace: %s\n", namespace)
+	result := make(map[string][]Pod, len(multi.clients))
+	var errs []error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name, cli := range multi.clients {
+		wg.Add(1)
+		go func(name string, cli *Client) {
+			defer wg.Done()
+			pods, err := cli.GetPodsWithOptionsContext(ctx, namespace, metav1.ListOptions{})
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("listing pods in cluster %q: %w", name, err))
+				return
+			}
+			result[name] = pods
+		}(name, cli)
+	}
+	wg.Wait()
+
+	err := errors.Join(errs...)
+	if err != nil {
+		log.Printf("Failed getting the pods of a few clusters, Err: %v", err)
+	}
+	return result, err
+}
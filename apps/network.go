@@ -0,0 +1,123 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NetworkPolicy represents the information of a network policy present in the kubernetes cluster
+type NetworkPolicy struct {
+	// Name of the network policy
+	Name string `json:"name"`
+	// PodSelector refers to the label selector of the pods the policy applies to, "<none>" selects all the pods of the namespace
+	PodSelector string `json:"podSelector"`
+	// PolicyTypes refers to the directions of the traffic the policy restricts ex:"Ingress/Egress"
+	PolicyTypes []string `json:"policyTypes"`
+	// Ingress refers to the summaries of the allowed ingress rules ex:"from: pods(app=web); ports: TCP/8080"
+	Ingress []string `json:"ingress"`
+	// Egress refers to the summaries of the allowed egress rules ex:"to: ipBlock(10.0.0.0/8); ports: any"
+	Egress []string `json:"egress"`
+}
+
+// describePeers summarizes the peers of a network policy rule, no peers means that any source/destination is allowed
+func describePeers(peers []networkingv1.NetworkPolicyPeer) string {
+	if len(peers) == 0 {
+		return "any"
+	}
+	var descriptions []string
+	for _, peer := range peers {
+		var selectors []string
+		if peer.NamespaceSelector != nil {
+			selectors = append(selectors, "namespaces("+metav1.FormatLabelSelector(peer.NamespaceSelector)+")")
+		}
+		if peer.PodSelector != nil {
+			selectors = append(selectors, "pods("+metav1.FormatLabelSelector(peer.PodSelector)+")")
+		}
+		if peer.IPBlock != nil {
+			ipBlock := peer.IPBlock.CIDR
+			if len(peer.IPBlock.Except) > 0 {
+				ipBlock += " except " + strings.Join(peer.IPBlock.Except, ",")
+			}
+			selectors = append(selectors, "ipBlock("+ipBlock+")")
+		}
+		descriptions = append(descriptions, strings.Join(selectors, " & "))
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+// describePorts summarizes the ports of a network policy rule, no ports means that all the ports are allowed
+func describePorts(ports []networkingv1.NetworkPolicyPort) string {
+	if len(ports) == 0 {
+		return "any"
+	}
+	var descriptions []string
+	for _, port := range ports {
+		protocol := "TCP"
+		if port.Protocol != nil {
+			protocol = string(*port.Protocol)
+		}
+		number := "*"
+		if port.Port != nil {
+			number = port.Port.String()
+			if port.EndPort != nil {
+				number = fmt.Sprintf("%s-%d", number, *port.EndPort)
+			}
+		}
+		descriptions = append(descriptions, protocol+"/"+number)
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+// newNetworkPolicy maps the given kubernetes network policy to the NetworkPolicy information
+func newNetworkPolicy(info networkingv1.NetworkPolicy) NetworkPolicy {
+	policy := NetworkPolicy{
+		Name:        info.ObjectMeta.Name,
+		PodSelector: metav1.FormatLabelSelector(&info.Spec.PodSelector),
+	}
+	for _, policyType := range info.Spec.PolicyTypes {
+		policy.PolicyTypes = append(policy.PolicyTypes, string(policyType))
+	}
+	for _, rule := range info.Spec.Ingress {
+		policy.Ingress = append(policy.Ingress, "from: "+describePeers(rule.From)+"; ports: "+describePorts(rule.Ports))
+	}
+	for _, rule := range info.Spec.Egress {
+		policy.Egress = append(policy.Egress, "to: "+describePeers(rule.To)+"; ports: "+describePorts(rule.Ports))
+	}
+	return policy
+}
+
+// GetNetworkPolicies is an API to fetch the network policies present in a given "namespace" along with the summaries of their rules.
+// A namespace without any network policy allows all the traffic by default.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetNetworkPolicies(namespace string) ([]NetworkPolicy, error) {
+	return cli.GetNetworkPoliciesContext(context.Background(), namespace)
+}
+
+// GetNetworkPoliciesContext is the context-aware variant of GetNetworkPolicies
+func (cli *Client) GetNetworkPoliciesContext(ctx context.Context, namespace string) ([]NetworkPolicy, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the network policies information, Namespace: %s\n", namespace)
+	policies, err := listAndMap(func() ([]networkingv1.NetworkPolicy, error) {
+		response, err := cli.NetworkingV1().NetworkPolicies(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return response.Items, nil
+	}, newNetworkPolicy)
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", policies)
+	return policies, nil
+}
@@ -0,0 +1,166 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+)
+
+// NodeCondition represents a condition of a node ex: whether it is Ready or under MemoryPressure/DiskPressure/PIDPressure
+type NodeCondition struct {
+	// Type of the condition ex:"Ready/MemoryPressure/DiskPressure/PIDPressure/NetworkUnavailable"
+	Type string `json:"type"`
+	// Status of the condition ex:"True/False/Unknown"
+	Status string `json:"status"`
+	// Reason refers to the short, machine understandable reason of the condition's last transition ex:"KubeletHasDiskPressure"
+	Reason string `json:"reason"`
+	// Message refers to the human readable details of the condition's last transition
+	Message string `json:"message"`
+	// LastTransitionTime refers to the time at which the condition last changed its status
+	LastTransitionTime time.Time `json:"lastTransitionTime"`
+}
+
+// GetNodeConditions is an API to fetch all the conditions of the node identified by "nodeName", which tell the pressure signals
+// (memory, disk, PIDs) that lead to the evictions of the pods, besides the readiness of the node. Nodes are cluster-scoped, hence there is
+// no namespace argument. The NotFound error of the k8s API is passed as is if the node doesn't exist.
+func (cli *Client) GetNodeConditions(nodeName string) ([]NodeCondition, error) {
+	ctx, cancel := cli.requestContext(context.Background())
+	defer cancel()
+	log.Printf("Getting the node conditions, Name: %s\n", nodeName)
+	response, err := cli.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	var conditions []NodeCondition
+	for _, condition := range response.Status.Conditions {
+		conditions = append(conditions, NodeCondition{
+			Type:               string(condition.Type),
+			Status:             string(condition.Status),
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+			LastTransitionTime: condition.LastTransitionTime.Time,
+		})
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", conditions)
+	return conditions, nil
+}
+
+// Taint represents a taint of a node, which repels the pods not tolerating it
+type Taint struct {
+	// Key of the taint ex:"node-role.kubernetes.io/control-plane"
+	Key string `json:"key"`
+	// Value of the taint, empty if the taint has no value
+	Value string `json:"value"`
+	// Effect of the taint on the pods not tolerating it ex:"NoSchedule/PreferNoSchedule/NoExecute"
+	Effect string `json:"effect"`
+}
+
+// String returns the taint in the kubectl format ex:"dedicated=gpu:NoSchedule"
+func (taint Taint) String() string {
+	if taint.Value == "" {
+		return taint.Key + ":" + taint.Effect
+	}
+	return taint.Key + "=" + taint.Value + ":" + taint.Effect
+}
+
+// GetNodeTaints is an API to fetch the taints of the node identified by "nodeName". Nodes are cluster-scoped, hence there is no namespace argument.
+// The NotFound error of the k8s API is passed as is if the node doesn't exist.
+func (cli *Client) GetNodeTaints(nodeName string) ([]Taint, error) {
+	ctx, cancel := cli.requestContext(context.Background())
+	defer cancel()
+	log.Printf("Getting the node taints, Name: %s\n", nodeName)
+	response, err := cli.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	var taints []Taint
+	for _, taint := range response.Spec.Taints {
+		taints = append(taints, Taint{Key: taint.Key, Value: taint.Value, Effect: string(taint.Effect)})
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", taints)
+	return taints, nil
+}
+
+// explainNodeMismatch returns the reasons why the given pod can't be scheduled on the given node as far as the taints, the cordon and the
+// node selector are concerned, empty if none of them prevents it. The taints with the PreferNoSchedule effect are only a preference and don't.
+func explainNodeMismatch(pod *apiv1.Pod, node apiv1.Node) []string {
+	var reasons []string
+	if node.Spec.Unschedulable {
+		reasons = append(reasons, "node is cordoned (unschedulable)")
+	}
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect == apiv1.TaintEffectPreferNoSchedule {
+			continue
+		}
+		tolerated := false
+		for _, toleration := range pod.Spec.Tolerations {
+			// the numeric comparison operators (Lt/Gt) are honored so that a toleration using them is not reported as a mismatch
+			if toleration.ToleratesTaint(klog.Background(), taint, true) {
+				tolerated = true
+				break
+			}
+		}
+		if !tolerated {
+			reasons = append(reasons, "untolerated taint "+Taint{Key: taint.Key, Value: taint.Value, Effect: string(taint.Effect)}.String())
+		}
+	}
+	if len(pod.Spec.NodeSelector) > 0 && !labels.SelectorFromSet(pod.Spec.NodeSelector).Matches(labels.Set(node.ObjectMeta.Labels)) {
+		reasons = append(reasons, fmt.Sprintf("node labels don't match the node selector %v", pod.Spec.NodeSelector))
+	}
+	return reasons
+}
+
+// ExplainUnschedulable is an API to explain why the pod identified by "podName" in the given "namespace" can't be scheduled, by comparing
+// its tolerations against the taints of every node, along with the cordoned nodes and its node selector. The explanation lists the nodes
+// the pod can't be scheduled on and why ex:
+//
+//	pod "web-0" can't be scheduled on 2 of 3 nodes:
+//	  control-plane-1: untolerated taint node-role.kubernetes.io/control-plane:NoSchedule
+//	  worker-2: node is cordoned (unschedulable)
+//
+// When no node is ruled out this way, the cause lies elsewhere (ex: insufficient resources, affinity) and is told by the PodScheduled
+// condition (see GetPodConditions). namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) ExplainUnschedulable(namespace, podName string) (string, error) {
+	ctx, cancel := cli.requestContext(context.Background())
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return "", err
+	}
+	log.Printf("Explaining the scheduling of the pod, Namespace: %s, Name: %s\n", namespace, podName)
+	pod, err := cli.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return "", err
+	}
+	if pod.Spec.NodeName != "" {
+		return fmt.Sprintf("pod %q is scheduled on node %q", podName, pod.Spec.NodeName), nil
+	}
+	nodes, err := cli.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return "", err
+	}
+	var lines []string
+	for _, node := range nodes.Items {
+		if reasons := explainNodeMismatch(pod, node); len(reasons) > 0 {
+			lines = append(lines, fmt.Sprintf("  %s: %s", node.ObjectMeta.Name, strings.Join(reasons, ", ")))
+		}
+	}
+	if len(lines) == 0 {
+		return fmt.Sprintf("pod %q is not ruled out of any of the %d nodes by taints, cordons or its node selector, check its PodScheduled condition", podName, len(nodes.Items)), nil
+	}
+	explanation := fmt.Sprintf("pod %q can't be scheduled on %d of %d nodes:\n%s", podName, len(lines), len(nodes.Items), strings.Join(lines, "\n"))
+	log.Printf("Explained the scheduling of the pod successfully, Explanation: %s\n", explanation)
+	return explanation, nil
+}
@@ -0,0 +1,269 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/transport"
+)
+
+// Option refers to a functional option which customizes the client being initialized by NewClient
+type Option func(cli *Client) error
+
+// WithTimeout sets the maximum length of time to wait before giving up on a single request to the Kubernetes API.
+// A zero value means no timeout.
+func WithTimeout(d time.Duration) Option {
+	return func(cli *Client) error {
+		cli.config.Timeout = d
+		return nil
+	}
+}
+
+// WithQPS sets the maximum queries per second allowed from the client to the Kubernetes API.
+// Raise it along with the burst (WithBurst) for heavy listing tools that are otherwise throttled client-side.
+func WithQPS(qps float32) Option {
+	return func(cli *Client) error {
+		cli.config.QPS = qps
+		return nil
+	}
+}
+
+// WithBurst sets the maximum burst of requests allowed from the client to the Kubernetes API on top of the QPS
+func WithBurst(burst int) Option {
+	return func(cli *Client) error {
+		cli.config.Burst = burst
+		return nil
+	}
+}
+
+// WithUserAgent sets the user agent sent along with every request to the Kubernetes API.
+// The user agent is recorded in the audit logs of the API server, which helps in telling apart the requests of different tools.
+func WithUserAgent(ua string) Option {
+	return func(cli *Client) error {
+		cli.config.UserAgent = ua
+		return nil
+	}
+}
+
+// WithMetricsRegistry instruments the client with Prometheus metrics registered with the given registry: the counter
+// "apps_client_api_requests_total" labeled by the verb, resource and result of the calls to the Kubernetes API and the histogram
+// "apps_client_api_request_duration_seconds" of their latency. The duration of a watch covers only its establishment.
+// Without this option no metrics are collected at all. An error is returned if the collectors are already registered with the registry,
+// hence a registry can't be shared by several clients ex: the clients of NewMultiClient, which would fail to be created.
+func WithMetricsRegistry(registry *prometheus.Registry) Option {
+	return func(cli *Client) error {
+		if registry == nil {
+			return fmt.Errorf("metrics registry must not be nil")
+		}
+		metrics, err := newAPIMetrics(registry)
+		if err != nil {
+			return fmt.Errorf("registering the client metrics: %w", err)
+		}
+		cli.config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+			return &metricsTransport{metrics: metrics, next: rt}
+		})
+		return nil
+	}
+}
+
+// WithTransportWrapper wraps the HTTP transport of the client with the given wrapper, ex: to instrument every request to the Kubernetes API.
+// The wrappers are applied in the order of the options, the one passed last sees the request first. To record each API call as a child span
+// of the trace carried by the request context, plug in OpenTelemetry's otelhttp:
+//
+//	apps.WithTransportWrapper(func(rt http.RoundTripper) http.RoundTripper {
+//		return otelhttp.NewTransport(rt)
+//	})
+//
+// An error is returned if the wrapper is nil.
+func WithTransportWrapper(fn transport.WrapperFunc) Option {
+	return func(cli *Client) error {
+		if fn == nil {
+			return fmt.Errorf("transport wrapper must not be nil")
+		}
+		cli.config.Wrap(fn)
+		return nil
+	}
+}
+
+// WithProxyURL routes the requests of the client to the Kubernetes API through the HTTP(S) proxy at the given URL ex:"http://proxy.corp:3128",
+// without changing the proxy environment variables of the whole process. An error is returned if the URL is malformed or is not absolute.
+func WithProxyURL(proxyURL string) Option {
+	return func(cli *Client) error {
+		parsed, err := url.Parse(proxyURL)
+		if err != nil {
+			return fmt.Errorf("invalid proxy URL %q: %w", proxyURL, err)
+		}
+		if parsed.Scheme == "" || parsed.Host == "" {
+			return fmt.Errorf("invalid proxy URL %q: scheme and host are required", proxyURL)
+		}
+		cli.config.Proxy = http.ProxyURL(parsed)
+		return nil
+	}
+}
+
+// WithInsecureSkipTLSVerify disables the verification of the API server's certificate, i.e. the client trusts any server it connects to.
+// It is meant strictly for the ephemeral dev/test clusters with self-signed certificates and is UNSAFE for production since it exposes
+// the credentials of the client to a man-in-the-middle. The configured CA data is cleared as client-go rejects it along with the insecure mode.
+// A warning is logged whenever the option is used.
+func WithInsecureSkipTLSVerify() Option {
+	return func(cli *Client) error {
+		log.Printf("WARNING: TLS verification of the API server is disabled, never use it in production, Host: %s\n", cli.config.Host)
+		cli.config.TLSClientConfig.Insecure = true
+		cli.config.TLSClientConfig.CAData = nil
+		cli.config.TLSClientConfig.CAFile = ""
+		return nil
+	}
+}
+
+// WithProtobuf makes the client talk to the Kubernetes API in protobuf rather than JSON, which considerably reduces the size and the decoding
+// time of the large list responses ex: thousands of pods. Protobuf applies only to the built-in types; the custom resources (CRDs) accessed
+// through the dynamic client, as well as the metrics API, keep using JSON.
+func WithProtobuf() Option {
+	return func(cli *Client) error {
+		cli.config.ContentType = runtime.ContentTypeProtobuf
+		cli.config.AcceptContentTypes = runtime.ContentTypeProtobuf + "," + runtime.ContentTypeJSON
+		return nil
+	}
+}
+
+// WithCache makes the client serve the reads of the pods and the deployments (GetPods, GetPodsFiltered, GetDeployments etc.) from the local
+// stores of shared informers, watching the whole cluster, instead of calling the Kubernetes API each time. The informers run until the given
+// context is done or the client is closed. The reads fall back to the API while the informers haven't synced and for the list options the
+// cache can't serve (field selectors, limits, resource versions). Note that the cache lags the API by the watch latency, i.e. a change is not
+// visible to the reads until its watch event has been received. The reads of a single pod (GetPod), the paginated StreamPods, the pods listed
+// by DeletePodsByLabel for their deletion and the watches always go to the API. Without this option every read goes to the API.
+func WithCache(ctx context.Context) Option {
+	return func(cli *Client) error {
+		if ctx == nil {
+			return fmt.Errorf("cache context must not be nil")
+		}
+		cli.cacheCtx = ctx
+		return nil
+	}
+}
+
+// WithShutdownContext closes the client (see Close) once the given context is done, which stops all the watches and informers started
+// through the client and closes their channels. Tie it to the termination signals of a service for the watches to drain on SIGTERM ex:
+//
+//	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM)
+//	defer stop()
+//	cli, err := apps.NewClient(apps.InCluster, apps.WithShutdownContext(ctx))
+func WithShutdownContext(ctx context.Context) Option {
+	return func(cli *Client) error {
+		if ctx == nil {
+			return fmt.Errorf("shutdown context must not be nil")
+		}
+		cli.shutdownCtx = ctx
+		return nil
+	}
+}
+
+// MutateOption refers to a functional option which customizes a single mutating (create/update/patch/delete) operation
+type MutateOption func(opts *mutateOptions)
+
+// mutateOptions holds the settings of a mutating operation
+type mutateOptions struct {
+	// dryRun refers to whether the operation is only validated and admitted by the API server without being persisted
+	dryRun bool
+}
+
+// WithDryRun makes the mutating operation a server-side dry run when enabled.
+// The request still goes through the validation and admission of the API server, and surfaces their errors, but the cluster state is not changed.
+func WithDryRun(enabled bool) MutateOption {
+	return func(opts *mutateOptions) {
+		opts.dryRun = enabled
+	}
+}
+
+// newMutateOptions applies the given functional options over the defaults of a mutating operation
+func newMutateOptions(opts []MutateOption) mutateOptions {
+	var options mutateOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
+
+// dryRunValue returns the value of the "DryRun" field of the create/update/patch/delete options of the k8s API
+func (opts mutateOptions) dryRunValue() []string {
+	if opts.dryRun {
+		return []string{metav1.DryRunAll}
+	}
+	return nil
+}
+
+// WithMaxWatchBackoff caps the time waited between the attempts of re-establishing a failed watch (30 seconds by default).
+// The wait grows exponentially, with jitter, from a second up to this maximum while the watch keeps failing.
+func WithMaxWatchBackoff(d time.Duration) Option {
+	return func(cli *Client) error {
+		if d <= 0 {
+			return fmt.Errorf("invalid maximum watch backoff: %v, it should be positive", d)
+		}
+		cli.watchMaxBackoff = d
+		return nil
+	}
+}
+
+// WithDiscoveryCacheTTL sets the time after which the discovery information cached by the client, used to map the kinds to the resources
+// for the dynamic operations (ResolveGVR, ApplyManifest etc.), is considered stale and rebuilt (10 minutes by default). Independently of
+// the TTL, the cache is invalidated as soon as a kind is not found (a NoMatchError), so that a CRD installed at runtime is picked up.
+func WithDiscoveryCacheTTL(d time.Duration) Option {
+	return func(cli *Client) error {
+		if d <= 0 {
+			return fmt.Errorf("invalid discovery cache TTL: %v, it should be positive", d)
+		}
+		cli.discoveryCacheTTL = d
+		return nil
+	}
+}
+
+// WithStrictNamespace makes the namespaced APIs fail with ErrEmptyNamespace when an empty string ("") namespace is passed, instead of
+// silently using the client's default namespace. It catches the bugs where a namespace variable was never populated.
+// The APIs which list across all the namespaces (ex: GetPodsGroupedByNodeAllNamespaces) and the dynamic APIs are not affected.
+func WithStrictNamespace() Option {
+	return func(cli *Client) error {
+		cli.strictNamespace = true
+		return nil
+	}
+}
+
+// WithDefaultTimeout sets the deadline applied to the calls to the k8s API (other than the watches and the log streams) made with a context
+// which has no deadline, 30 seconds by default. An explicit deadline on the passed context always takes precedence. A zero value disables it.
+func WithDefaultTimeout(d time.Duration) Option {
+	return func(cli *Client) error {
+		cli.defaultTimeout = d
+		return nil
+	}
+}
+
+// WithImpersonation makes the client act as the given "user" and member of the given "groups" for every request, ex: to run CanI checks
+// or reads as a specific identity without a separate kubeconfig. The identity of the client itself must be granted the "impersonate" verb
+// on the users/groups being impersonated, else the requests are rejected as forbidden.
+func WithImpersonation(user string, groups []string) Option {
+	return func(cli *Client) error {
+		cli.config.Impersonate = rest.ImpersonationConfig{
+			UserName: user,
+			Groups:   groups,
+		}
+		return nil
+	}
+}
+
+// WithDefaultNamespace sets the namespace used by the APIs when an empty string ("") namespace is passed, the kubernetes' "default" namespace otherwise
+func WithDefaultNamespace(namespace string) Option {
+	return func(cli *Client) error {
+		if namespace == "" {
+			return fmt.Errorf("invalid default namespace: it should not be empty")
+		}
+		cli.namespace = namespace
+		return nil
+	}
+}
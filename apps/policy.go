@@ -0,0 +1,111 @@
+package apps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ErrEvictionBlocked is returned when an eviction is rejected because it would violate a pod disruption budget
+var ErrEvictionBlocked = errors.New("eviction blocked by a pod disruption budget")
+
+// PodDisruptionBudget represents the information of a pod disruption budget present in the kubernetes cluster
+type PodDisruptionBudget struct {
+	// Name of the pod disruption budget
+	Name string `json:"name"`
+	// MinAvailable refers to the number/percentage of the pods which must remain available, empty if not set
+	MinAvailable string `json:"minAvailable"`
+	// MaxUnavailable refers to the number/percentage of the pods which can be unavailable, empty if not set
+	MaxUnavailable string `json:"maxUnavailable"`
+	// CurrentHealthy refers to the current number of the healthy pods
+	CurrentHealthy int `json:"currentHealthy"`
+	// DesiredHealthy refers to the minimum number of the healthy pods desired
+	DesiredHealthy int `json:"desiredHealthy"`
+	// DisruptionsAllowed refers to the number of the pod disruptions currently allowed, zero means that an eviction (drain) will be blocked
+	DisruptionsAllowed int `json:"disruptionsAllowed"`
+}
+
+// newPodDisruptionBudget maps the given kubernetes pod disruption budget to the PodDisruptionBudget information
+func newPodDisruptionBudget(info policyv1.PodDisruptionBudget) PodDisruptionBudget {
+	budget := PodDisruptionBudget{
+		Name:               info.ObjectMeta.Name,
+		CurrentHealthy:     int(info.Status.CurrentHealthy),
+		DesiredHealthy:     int(info.Status.DesiredHealthy),
+		DisruptionsAllowed: int(info.Status.DisruptionsAllowed),
+	}
+	if info.Spec.MinAvailable != nil {
+		budget.MinAvailable = info.Spec.MinAvailable.String()
+	}
+	if info.Spec.MaxUnavailable != nil {
+		budget.MaxUnavailable = info.Spec.MaxUnavailable.String()
+	}
+	return budget
+}
+
+// GetPodDisruptionBudgets is an API to fetch the pod disruption budgets present in a given "namespace" along with their current status.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetPodDisruptionBudgets(namespace string) ([]PodDisruptionBudget, error) {
+	return cli.GetPodDisruptionBudgetsContext(context.Background(), namespace)
+}
+
+// GetPodDisruptionBudgetsContext is the context-aware variant of GetPodDisruptionBudgets
+func (cli *Client) GetPodDisruptionBudgetsContext(ctx context.Context, namespace string) ([]PodDisruptionBudget, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the pod disruption budgets information, Namespace: %s\n", namespace)
+	budgets, err := listAndMap(func() ([]policyv1.PodDisruptionBudget, error) {
+		response, err := cli.PolicyV1().PodDisruptionBudgets(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return response.Items, nil
+	}, newPodDisruptionBudget)
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", budgets)
+	return budgets, nil
+}
+
+// EvictPod is an API to evict the pod identified by "podName" in the given "namespace" through the eviction subresource, i.e. the "polite" way
+// which respects the pod disruption budgets, unlike a raw delete. "gracePeriodSeconds" overrides the grace period of the pod if it is not nil.
+// When the eviction is rejected by a pod disruption budget an error wrapping ErrEvictionBlocked (and the TooManyRequests error of the k8s API)
+// is returned, so that the callers can decide whether to retry later or to force-delete the pod.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) EvictPod(ctx context.Context, namespace, podName string, gracePeriodSeconds *int64, opts ...MutateOption) error {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	options := newMutateOptions(opts)
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return err
+	}
+	log.Printf("Evicting the pod, Namespace: %s, Name: %s, Dry Run: %v\n", namespace, podName, options.dryRun)
+	eviction := &policyv1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{Name: podName, Namespace: namespace},
+		DeleteOptions: &metav1.DeleteOptions{
+			GracePeriodSeconds: gracePeriodSeconds,
+			DryRun:             options.dryRunValue(),
+		},
+	}
+	err = cli.CoreV1().Pods(namespace).EvictV1(ctx, eviction)
+	if err != nil {
+		log.Printf("Failed evicting the pod, Err: %v", err)
+		if apierrors.IsTooManyRequests(err) {
+			return fmt.Errorf("%w: %w", ErrEvictionBlocked, err)
+		}
+		return err
+	}
+	log.Printf("Evicted the pod successfully\n")
+	return nil
+}
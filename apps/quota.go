@@ -0,0 +1,134 @@
+package apps
+
+import (
+	"context"
+	"log"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResourceQuota represents the hard limits and the current usage of a resource quota present in a namespace
+type ResourceQuota struct {
+	// Name of the resource quota
+	Name string `json:"name"`
+	// Hard refers to the enforced hard limits keyed by the resource name ex:"requests.cpu/pods" etc.
+	Hard map[string]string `json:"hard"`
+	// Used refers to the current usage of the namespace keyed by the resource name
+	Used map[string]string `json:"used"`
+}
+
+// getResourceListStrings converts the given resource list to a map of the resource names and their quantities
+func getResourceListStrings(resources apiv1.ResourceList) map[string]string {
+	values := make(map[string]string, len(resources))
+	for name, quantity := range resources {
+		values[string(name)] = quantity.String()
+	}
+	return values
+}
+
+// newResourceQuota maps the given kubernetes resource quota to the ResourceQuota information
+func newResourceQuota(info apiv1.ResourceQuota) ResourceQuota {
+	return ResourceQuota{
+		Name: info.ObjectMeta.Name,
+		Hard: getResourceListStrings(info.Status.Hard),
+		Used: getResourceListStrings(info.Status.Used),
+	}
+}
+
+// GetResourceQuotas is an API to fetch the resource quotas present in a given "namespace" along with their usage.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetResourceQuotas(namespace string) ([]ResourceQuota, error) {
+	return cli.GetResourceQuotasContext(context.Background(), namespace)
+}
+
+// GetResourceQuotasContext is the context-aware variant of GetResourceQuotas
+func (cli *Client) GetResourceQuotasContext(ctx context.Context, namespace string) ([]ResourceQuota, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the resource quotas information, Namespace: %s\n", namespace)
+	quotas, err := listAndMap(func() ([]apiv1.ResourceQuota, error) {
+		response, err := cli.CoreV1().ResourceQuotas(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return response.Items, nil
+	}, newResourceQuota)
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", quotas)
+	return quotas, nil
+}
+
+// LimitRangeItem represents the constraints a limit range enforces on a type of object, the resource values are keyed by the resource name
+type LimitRangeItem struct {
+	// Type of the object constrained ex:"Container/Pod/PersistentVolumeClaim"
+	Type string `json:"type"`
+	// Default refers to the limits injected into the containers which don't specify them
+	Default map[string]string `json:"default"`
+	// DefaultRequest refers to the requests injected into the containers which don't specify them
+	DefaultRequest map[string]string `json:"defaultRequest"`
+	// Max refers to the maximum usage allowed
+	Max map[string]string `json:"max"`
+	// Min refers to the minimum usage required
+	Min map[string]string `json:"min"`
+}
+
+// LimitRange represents the information of a limit range present in a namespace
+type LimitRange struct {
+	// Name of the limit range
+	Name string `json:"name"`
+	// Limits refers to the constraints of the limit range
+	Limits []LimitRangeItem `json:"limits"`
+}
+
+// newLimitRange maps the given kubernetes limit range to the LimitRange information
+func newLimitRange(info apiv1.LimitRange) LimitRange {
+	limitRange := LimitRange{Name: info.ObjectMeta.Name}
+	for _, item := range info.Spec.Limits {
+		limitRange.Limits = append(limitRange.Limits, LimitRangeItem{
+			Type:           string(item.Type),
+			Default:        getResourceListStrings(item.Default),
+			DefaultRequest: getResourceListStrings(item.DefaultRequest),
+			Max:            getResourceListStrings(item.Max),
+			Min:            getResourceListStrings(item.Min),
+		})
+	}
+	return limitRange
+}
+
+// GetLimitRanges is an API to fetch the limit ranges present in a given "namespace", which explain the default requests/limits injected into the pods.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetLimitRanges(namespace string) ([]LimitRange, error) {
+	return cli.GetLimitRangesContext(context.Background(), namespace)
+}
+
+// GetLimitRangesContext is the context-aware variant of GetLimitRanges
+func (cli *Client) GetLimitRangesContext(ctx context.Context, namespace string) ([]LimitRange, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the limit ranges information, Namespace: %s\n", namespace)
+	limitRanges, err := listAndMap(func() ([]apiv1.LimitRange, error) {
+		response, err := cli.CoreV1().LimitRanges(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return response.Items, nil
+	}, newLimitRange)
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", limitRanges)
+	return limitRanges, nil
+}
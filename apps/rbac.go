@@ -0,0 +1,313 @@
+package apps
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apiv1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ServiceAccount represents the information of a service account present in the kubernetes cluster
+type ServiceAccount struct {
+	// Name of the service account
+	Name string `json:"name"`
+	// Secrets refers to the names of the secrets (tokens) the pods running as this service account are allowed to use
+	Secrets []string `json:"secrets"`
+	// ImagePullSecrets refers to the names of the secrets used for pulling the images of the pods running as this service account
+	ImagePullSecrets []string `json:"imagePullSecrets"`
+}
+
+// newServiceAccount maps the given kubernetes service account to the ServiceAccount information
+func newServiceAccount(info apiv1.ServiceAccount) ServiceAccount {
+	serviceAccount := ServiceAccount{Name: info.ObjectMeta.Name}
+	for _, secret := range info.Secrets {
+		serviceAccount.Secrets = append(serviceAccount.Secrets, secret.Name)
+	}
+	for _, secret := range info.ImagePullSecrets {
+		serviceAccount.ImagePullSecrets = append(serviceAccount.ImagePullSecrets, secret.Name)
+	}
+	return serviceAccount
+}
+
+// GetServiceAccounts is an API to fetch the service accounts present in a given "namespace" along with the secrets they reference.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetServiceAccounts(namespace string) ([]ServiceAccount, error) {
+	return cli.GetServiceAccountsContext(context.Background(), namespace)
+}
+
+// GetServiceAccountsContext is the context-aware variant of GetServiceAccounts
+func (cli *Client) GetServiceAccountsContext(ctx context.Context, namespace string) ([]ServiceAccount, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the service accounts information, Namespace: %s\n", namespace)
+	serviceAccounts, err := listAndMap(func() ([]apiv1.ServiceAccount, error) {
+		response, err := cli.CoreV1().ServiceAccounts(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return response.Items, nil
+	}, newServiceAccount)
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", serviceAccounts)
+	return serviceAccounts, nil
+}
+
+// PolicyRule represents a single rule of a role, i.e. the verbs allowed on the listed resources/URLs
+type PolicyRule struct {
+	// Verbs allowed by the rule ex:"get/list/watch" etc.
+	Verbs []string `json:"verbs"`
+	// APIGroups of the resources, an empty string ("") refers to the core API group
+	APIGroups []string `json:"apiGroups"`
+	// Resources the rule applies to ex:"pods/deployments" etc.
+	Resources []string `json:"resources"`
+	// ResourceNames restricts the rule to the named resources if set
+	ResourceNames []string `json:"resourceNames"`
+	// NonResourceURLs the rule applies to ex:"/healthz", set only in cluster roles
+	NonResourceURLs []string `json:"nonResourceURLs"`
+}
+
+// Subject represents an identity (user, group or service account) a role is bound to
+type Subject struct {
+	// Kind of the subject ex:"User/Group/ServiceAccount"
+	Kind string `json:"kind"`
+	// Name of the subject
+	Name string `json:"name"`
+	// Namespace of the subject, set only for the service accounts
+	Namespace string `json:"namespace"`
+}
+
+// Role represents the information of a namespaced role present in the kubernetes cluster
+type Role struct {
+	// Name of the role
+	Name string `json:"name"`
+	// PolicyRules refers to the rules granted by the role
+	PolicyRules []PolicyRule `json:"policyRules"`
+}
+
+// RoleBinding represents the information of a namespaced role binding present in the kubernetes cluster
+type RoleBinding struct {
+	// Name of the role binding
+	Name string `json:"name"`
+	// RoleRef refers to the role granted by the binding in the "<kind>/<name>" format ex:"ClusterRole/view"
+	RoleRef string `json:"roleRef"`
+	// Subjects refers to the identities the role is granted to
+	Subjects []Subject `json:"subjects"`
+}
+
+// newPolicyRules maps the given kubernetes policy rules to the PolicyRule information
+func newPolicyRules(rules []rbacv1.PolicyRule) []PolicyRule {
+	var policyRules []PolicyRule
+	for _, rule := range rules {
+		policyRules = append(policyRules, PolicyRule{
+			Verbs:           rule.Verbs,
+			APIGroups:       rule.APIGroups,
+			Resources:       rule.Resources,
+			ResourceNames:   rule.ResourceNames,
+			NonResourceURLs: rule.NonResourceURLs,
+		})
+	}
+	return policyRules
+}
+
+// newSubjects maps the given kubernetes subjects to the Subject information
+func newSubjects(subjects []rbacv1.Subject) []Subject {
+	var result []Subject
+	for _, subject := range subjects {
+		result = append(result, Subject{
+			Kind:      subject.Kind,
+			Name:      subject.Name,
+			Namespace: subject.Namespace,
+		})
+	}
+	return result
+}
+
+// GetRoles is an API to fetch the roles present in a given "namespace" along with their rules.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetRoles(namespace string) ([]Role, error) {
+	return cli.GetRolesContext(context.Background(), namespace)
+}
+
+// GetRolesContext is the context-aware variant of GetRoles
+func (cli *Client) GetRolesContext(ctx context.Context, namespace string) ([]Role, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the roles information, Namespace: %s\n", namespace)
+	roles, err := listAndMap(func() ([]rbacv1.Role, error) {
+		response, err := cli.RbacV1().Roles(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return response.Items, nil
+	}, func(info rbacv1.Role) Role {
+		return Role{
+			Name:        info.ObjectMeta.Name,
+			PolicyRules: newPolicyRules(info.Rules),
+		}
+	})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", roles)
+	return roles, nil
+}
+
+// GetRoleBindings is an API to fetch the role bindings present in a given "namespace" along with their subjects.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetRoleBindings(namespace string) ([]RoleBinding, error) {
+	return cli.GetRoleBindingsContext(context.Background(), namespace)
+}
+
+// GetRoleBindingsContext is the context-aware variant of GetRoleBindings
+func (cli *Client) GetRoleBindingsContext(ctx context.Context, namespace string) ([]RoleBinding, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the role bindings information, Namespace: %s\n", namespace)
+	roleBindings, err := listAndMap(func() ([]rbacv1.RoleBinding, error) {
+		response, err := cli.RbacV1().RoleBindings(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return response.Items, nil
+	}, func(info rbacv1.RoleBinding) RoleBinding {
+		return RoleBinding{
+			Name:     info.ObjectMeta.Name,
+			RoleRef:  info.RoleRef.Kind + "/" + info.RoleRef.Name,
+			Subjects: newSubjects(info.Subjects),
+		}
+	})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", roleBindings)
+	return roleBindings, nil
+}
+
+// CanI is an API to check whether the identity of the client is allowed to perform the "verb" on the "resource" in the given "namespace".
+// The resource can be qualified by its API group and subresource ex:"deployments.apps" or "pods/log".
+// Unlike the other APIs, an empty string ("") namespace is not defaulted and checks the permission across all the namespaces/cluster-scoped resources.
+func (cli *Client) CanI(ctx context.Context, verb, resource, namespace string) (bool, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	attributes := &authorizationv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      verb,
+		Resource:  resource,
+	}
+	if index := strings.Index(attributes.Resource, "/"); index >= 0 {
+		attributes.Resource, attributes.Subresource = attributes.Resource[:index], attributes.Resource[index+1:]
+	}
+	if index := strings.Index(attributes.Resource, "."); index >= 0 {
+		attributes.Resource, attributes.Group = attributes.Resource[:index], attributes.Resource[index+1:]
+	}
+	log.Printf("Checking the access, Verb: %s, Resource: %s, Namespace: %s\n", verb, resource, namespace)
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: attributes},
+	}
+	response, err := cli.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return false, err
+	}
+	return response.Status.Allowed, nil
+}
+
+// ClusterRole represents the information of a cluster-scoped role present in the kubernetes cluster
+type ClusterRole struct {
+	// Name of the cluster role
+	Name string `json:"name"`
+	// PolicyRules refers to the rules granted by the cluster role
+	PolicyRules []PolicyRule `json:"policyRules"`
+}
+
+// ClusterRoleBinding represents the information of a cluster-scoped role binding present in the kubernetes cluster
+type ClusterRoleBinding struct {
+	// Name of the cluster role binding
+	Name string `json:"name"`
+	// RoleRef refers to the cluster role granted by the binding in the "<kind>/<name>" format ex:"ClusterRole/cluster-admin"
+	RoleRef string `json:"roleRef"`
+	// Subjects refers to the identities the cluster role is granted to across the cluster
+	Subjects []Subject `json:"subjects"`
+}
+
+// GetClusterRoles is an API to fetch the cluster roles along with their rules
+func (cli *Client) GetClusterRoles() ([]ClusterRole, error) {
+	return cli.GetClusterRolesContext(context.Background())
+}
+
+// GetClusterRolesContext is the context-aware variant of GetClusterRoles
+func (cli *Client) GetClusterRolesContext(ctx context.Context) ([]ClusterRole, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	log.Printf("Getting the cluster roles information\n")
+	clusterRoles, err := listAndMap(func() ([]rbacv1.ClusterRole, error) {
+		response, err := cli.RbacV1().ClusterRoles().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return response.Items, nil
+	}, func(info rbacv1.ClusterRole) ClusterRole {
+		return ClusterRole{
+			Name:        info.ObjectMeta.Name,
+			PolicyRules: newPolicyRules(info.Rules),
+		}
+	})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", clusterRoles)
+	return clusterRoles, nil
+}
+
+// GetClusterRoleBindings is an API to fetch the cluster role bindings along with their subjects
+func (cli *Client) GetClusterRoleBindings() ([]ClusterRoleBinding, error) {
+	return cli.GetClusterRoleBindingsContext(context.Background())
+}
+
+// GetClusterRoleBindingsContext is the context-aware variant of GetClusterRoleBindings
+func (cli *Client) GetClusterRoleBindingsContext(ctx context.Context) ([]ClusterRoleBinding, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	log.Printf("Getting the cluster role bindings information\n")
+	clusterRoleBindings, err := listAndMap(func() ([]rbacv1.ClusterRoleBinding, error) {
+		response, err := cli.RbacV1().ClusterRoleBindings().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return response.Items, nil
+	}, func(info rbacv1.ClusterRoleBinding) ClusterRoleBinding {
+		return ClusterRoleBinding{
+			Name:     info.ObjectMeta.Name,
+			RoleRef:  info.RoleRef.Kind + "/" + info.RoleRef.Name,
+			Subjects: newSubjects(info.Subjects),
+		}
+	})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", clusterRoleBindings)
+	return clusterRoleBindings, nil
+}
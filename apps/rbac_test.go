@@ -0,0 +1,53 @@
+package apps
+
+import (
+	"context"
+	"testing"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// TestCanI checks that the resource is split into its group and subresource and that the decision of the review is returned
+func TestCanI(t *testing.T) {
+	tests := []struct {
+		name      string
+		verb      string
+		resource  string
+		namespace string
+		want      authorizationv1.ResourceAttributes
+	}{
+		{name: "core", verb: "get", resource: "pods", namespace: "default",
+			want: authorizationv1.ResourceAttributes{Namespace: "default", Verb: "get", Resource: "pods"}},
+		{name: "subresource", verb: "get", resource: "pods/log", namespace: "default",
+			want: authorizationv1.ResourceAttributes{Namespace: "default", Verb: "get", Resource: "pods", Subresource: "log"}},
+		{name: "group", verb: "list", resource: "deployments.apps", namespace: "",
+			want: authorizationv1.ResourceAttributes{Verb: "list", Resource: "deployments", Group: "apps"}},
+		{name: "group and subresource", verb: "update", resource: "deployments.apps/scale", namespace: "default",
+			want: authorizationv1.ResourceAttributes{Namespace: "default", Verb: "update", Resource: "deployments", Group: "apps", Subresource: "scale"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cli, clientset := newFakeClient(t)
+			var got authorizationv1.ResourceAttributes
+			clientset.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+				got = *review.Spec.ResourceAttributes
+				review.Status.Allowed = got.Resource == "pods"
+				return true, review, nil
+			})
+
+			allowed, err := cli.CanI(context.Background(), test.verb, test.resource, test.namespace)
+			if err != nil {
+				t.Fatalf("checking the access failed, Err: %v", err)
+			}
+			if got != test.want {
+				t.Errorf("expected the attributes %+v, got: %+v", test.want, got)
+			}
+			if want := test.want.Resource == "pods"; allowed != want {
+				t.Errorf("expected the access to be %v, got: %v", want, allowed)
+			}
+		})
+	}
+}
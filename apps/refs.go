@@ -0,0 +1,222 @@
+package apps
+
+import (
+	"context"
+	"log"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MissingRef represents a reference of a pod to a config map/secret, or to one of its keys, which doesn't exist.
+// Such a pod is stuck ex: in ContainerCreating or CreateContainerConfigError.
+type MissingRef struct {
+	// Pod refers to the name of the pod holding the reference
+	Pod string `json:"pod"`
+	// Name refers to the name of the referenced config map/secret
+	Name string `json:"name"`
+	// Key refers to the missing key of the config map/secret, empty when the config map/secret itself is missing
+	Key string `json:"key"`
+}
+
+// objectKeyRef represents a reference of a pod to a config map/secret, or to one of its keys when the key is not empty
+type objectKeyRef struct {
+	name     string
+	key      string
+	optional bool
+}
+
+// isOptional returns whether the given optional flag of a reference is set
+func isOptional(optional *bool) bool {
+	return optional != nil && *optional
+}
+
+// keyToPathRefs returns the references of a volume projecting the given items of a config map/secret, all the keys if there are no items
+func keyToPathRefs(name string, items []apiv1.KeyToPath, optional *bool) []objectKeyRef {
+	if len(items) == 0 {
+		return []objectKeyRef{{name: name, optional: isOptional(optional)}}
+	}
+	var refs []objectKeyRef
+	for _, item := range items {
+		refs = append(refs, objectKeyRef{name: name, key: item.Key, optional: isOptional(optional)})
+	}
+	return refs
+}
+
+// configMapRefs returns the references of the given pod spec to the config maps, through the environment of its (init) containers and its volumes
+func configMapRefs(spec apiv1.PodSpec) []objectKeyRef {
+	var refs []objectKeyRef
+	containers := append(append([]apiv1.Container{}, spec.InitContainers...), spec.Containers...)
+	for _, container := range containers {
+		for _, env := range container.Env {
+			if env.ValueFrom != nil && env.ValueFrom.ConfigMapKeyRef != nil {
+				ref := env.ValueFrom.ConfigMapKeyRef
+				refs = append(refs, objectKeyRef{name: ref.Name, key: ref.Key, optional: isOptional(ref.Optional)})
+			}
+		}
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.ConfigMapRef != nil {
+				refs = append(refs, objectKeyRef{name: envFrom.ConfigMapRef.Name, optional: isOptional(envFrom.ConfigMapRef.Optional)})
+			}
+		}
+	}
+	for _, volume := range spec.Volumes {
+		if volume.ConfigMap != nil {
+			refs = append(refs, keyToPathRefs(volume.ConfigMap.Name, volume.ConfigMap.Items, volume.ConfigMap.Optional)...)
+		}
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.ConfigMap != nil {
+					refs = append(refs, keyToPathRefs(source.ConfigMap.Name, source.ConfigMap.Items, source.ConfigMap.Optional)...)
+				}
+			}
+		}
+	}
+	return refs
+}
+
+// secretRefs returns the references of the given pod spec to the secrets, through the environment of its (init) containers, its volumes
+// and its image pull secrets
+func secretRefs(spec apiv1.PodSpec) []objectKeyRef {
+	var refs []objectKeyRef
+	containers := append(append([]apiv1.Container{}, spec.InitContainers...), spec.Containers...)
+	for _, container := range containers {
+		for _, env := range container.Env {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+				ref := env.ValueFrom.SecretKeyRef
+				refs = append(refs, objectKeyRef{name: ref.Name, key: ref.Key, optional: isOptional(ref.Optional)})
+			}
+		}
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.SecretRef != nil {
+				refs = append(refs, objectKeyRef{name: envFrom.SecretRef.Name, optional: isOptional(envFrom.SecretRef.Optional)})
+			}
+		}
+	}
+	for _, volume := range spec.Volumes {
+		if volume.Secret != nil {
+			refs = append(refs, keyToPathRefs(volume.Secret.SecretName, volume.Secret.Items, volume.Secret.Optional)...)
+		}
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.Secret != nil {
+					refs = append(refs, keyToPathRefs(source.Secret.Name, source.Secret.Items, source.Secret.Optional)...)
+				}
+			}
+		}
+	}
+	for _, pullSecret := range spec.ImagePullSecrets {
+		refs = append(refs, objectKeyRef{name: pullSecret.Name})
+	}
+	return refs
+}
+
+// findMissingRefs returns the references of the given pods, extracted by "refs", which point at a config map/secret or a key
+// not present in "keys", the keys of the existing config maps/secrets by their name. The optional references are skipped.
+func findMissingRefs(pods []apiv1.Pod, keys map[string]map[string]bool, refs func(spec apiv1.PodSpec) []objectKeyRef) []MissingRef {
+	var missing []MissingRef
+	for _, pod := range pods {
+		// the same reference is usually shared by several containers of a pod, it is reported once
+		seen := make(map[objectKeyRef]bool)
+		for _, ref := range refs(pod.Spec) {
+			if ref.optional || seen[ref] {
+				continue
+			}
+			seen[ref] = true
+			objectKeys, ok := keys[ref.name]
+			if ok && (ref.key == "" || objectKeys[ref.key]) {
+				continue
+			}
+			missingRef := MissingRef{Pod: pod.ObjectMeta.Name, Name: ref.name}
+			if ok {
+				missingRef.Key = ref.key
+			}
+			missing = append(missing, missingRef)
+		}
+	}
+	return missing
+}
+
+// FindMissingConfigMapRefs is an API to find the references of the pods present in the given "namespace" to the config maps, or to their keys,
+// which don't exist. The references are taken from the "configMapKeyRef"/"configMapRef" of the environment of the (init) containers and from
+// the config map volumes, projected ones included. The references marked optional are skipped as they don't block the pods.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) FindMissingConfigMapRefs(namespace string) ([]MissingRef, error) {
+	return cli.FindMissingConfigMapRefsContext(context.Background(), namespace)
+}
+
+// FindMissingConfigMapRefsContext is the context-aware variant of FindMissingConfigMapRefs
+func (cli *Client) FindMissingConfigMapRefsContext(ctx context.Context, namespace string) ([]MissingRef, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Finding the missing config map references, Namespace: %s\n", namespace)
+	pods, err := cli.listPodObjects(ctx, namespace, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	configMaps, err := cli.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	keys := make(map[string]map[string]bool)
+	for _, configMap := range configMaps.Items {
+		configMapKeys := make(map[string]bool)
+		for key := range configMap.Data {
+			configMapKeys[key] = true
+		}
+		for key := range configMap.BinaryData {
+			configMapKeys[key] = true
+		}
+		keys[configMap.ObjectMeta.Name] = configMapKeys
+	}
+	missing := findMissingRefs(pods, keys, configMapRefs)
+	log.Printf("Fetched information successfully, Info: %v\n", missing)
+	return missing, nil
+}
+
+// FindMissingSecretRefs is an API to find the references of the pods present in the given "namespace" to the secrets, or to their keys,
+// which don't exist, the usual cause of the pods stuck in CreateContainerConfigError. The references are taken from the "secretKeyRef"/"secretRef"
+// of the environment of the (init) containers, from the secret volumes, projected ones included, and from the image pull secrets.
+// The references marked optional are skipped as they don't block the pods. The values of the secrets are never exposed.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) FindMissingSecretRefs(namespace string) ([]MissingRef, error) {
+	return cli.FindMissingSecretRefsContext(context.Background(), namespace)
+}
+
+// FindMissingSecretRefsContext is the context-aware variant of FindMissingSecretRefs
+func (cli *Client) FindMissingSecretRefsContext(ctx context.Context, namespace string) ([]MissingRef, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Finding the missing secret references, Namespace: %s\n", namespace)
+	pods, err := cli.listPodObjects(ctx, namespace, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	secrets, err := cli.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	keys := make(map[string]map[string]bool)
+	for _, secret := range secrets.Items {
+		secretKeys := make(map[string]bool)
+		for key := range secret.Data {
+			secretKeys[key] = true
+		}
+		keys[secret.ObjectMeta.Name] = secretKeys
+	}
+	missing := findMissingRefs(pods, keys, secretRefs)
+	log.Printf("Fetched information successfully, Info: %v\n", missing)
+	return missing, nil
+}
@@ -0,0 +1,91 @@
+package apps
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"log"
+	"sort"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// Secret represents the information of a secret present in the kubernetes cluster. The values of the secret are deliberately left out.
+type Secret struct {
+	// Name of the secret
+	Name string `json:"name"`
+	// Type of the secret ex:"kubernetes.io/tls/kubernetes.io/dockerconfigjson/Opaque" etc.
+	Type string `json:"type"`
+	// Keys refers to the sorted keys of the data held by the secret
+	Keys []string `json:"keys"`
+	// NotAfter refers to the expiry of the certificate held by a "kubernetes.io/tls" secret.
+	// It is nil for the other types of secrets and when the certificate can't be parsed.
+	NotAfter *time.Time `json:"notAfter,omitempty"`
+}
+
+// getCertificateNotAfter returns the expiry of the first (leaf) certificate of the given PEM encoded chain, nil if it can't be parsed
+func getCertificateNotAfter(data []byte) *time.Time {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil
+	}
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil
+	}
+	return &certificate.NotAfter
+}
+
+// newSecret maps the given kubernetes secret to the Secret information, exposing the names of its keys but never their values
+func newSecret(info apiv1.Secret) Secret {
+	secret := Secret{
+		Name: info.ObjectMeta.Name,
+		Type: string(info.Type),
+	}
+	for key := range info.Data {
+		secret.Keys = append(secret.Keys, key)
+	}
+	sort.Strings(secret.Keys)
+	if info.Type == apiv1.SecretTypeTLS {
+		secret.NotAfter = getCertificateNotAfter(info.Data[apiv1.TLSCertKey])
+	}
+	return secret
+}
+
+// GetSecretsByType is an API to fetch the secrets of the given "secretType" ex:"kubernetes.io/tls" or "kubernetes.io/dockerconfigjson"
+// present in the given "namespace". The expiry of the certificate is parsed from the "tls.crt" of the TLS secrets, which helps in
+// spotting the certificates about to expire. namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetSecretsByType(namespace string, secretType apiv1.SecretType) ([]Secret, error) {
+	return cli.GetSecretsByTypeContext(context.Background(), namespace, secretType)
+}
+
+// GetSecretsByTypeContext is the context-aware variant of GetSecretsByType
+func (cli *Client) GetSecretsByTypeContext(ctx context.Context, namespace string, secretType apiv1.SecretType) ([]Secret, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the secrets information, Namespace: %s, Type: %s\n", namespace, secretType)
+	fieldSelector := fields.OneTermEqualSelector("type", string(secretType)).String()
+	secrets, err := listAndMap(func() ([]apiv1.Secret, error) {
+		response, err := cli.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{FieldSelector: fieldSelector})
+		if err != nil {
+			return nil, err
+		}
+		// the field selector is honored by the API server, the check only guards against a server ignoring it
+		return filterItems(response.Items, func(info apiv1.Secret) bool {
+			return info.Type == secretType
+		}), nil
+	}, newSecret)
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", secrets)
+	return secrets, nil
+}
@@ -0,0 +1,183 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// EndpointAddress represents a single backend address of a service present in the kubernetes cluster
+type EndpointAddress struct {
+	// IP of the backend
+	IP string `json:"ip"`
+	// Hostname of the backend if any
+	Hostname string `json:"hostname"`
+	// NodeName refers to the node hosting the backend if any
+	NodeName string `json:"nodeName"`
+	// Ready represents if the backend is ready to serve the traffic
+	Ready bool `json:"ready"`
+	// TargetRef refers to the object backing the address in the "<kind>/<name>" format ex:"Pod/web-0", empty if not set
+	TargetRef string `json:"targetRef"`
+}
+
+// newEndpointAddress maps the given address of the kubernetes endpoint (of an EndpointSlice) to the EndpointAddress information
+func newEndpointAddress(info discoveryv1.Endpoint, address string) EndpointAddress {
+	endpoint := EndpointAddress{
+		IP:    address,
+		Ready: isEndpointReady(info),
+	}
+	if info.Hostname != nil {
+		endpoint.Hostname = *info.Hostname
+	}
+	if info.NodeName != nil {
+		endpoint.NodeName = *info.NodeName
+	}
+	if info.TargetRef != nil {
+		endpoint.TargetRef = info.TargetRef.Kind + "/" + info.TargetRef.Name
+	}
+	return endpoint
+}
+
+// isEndpointReady returns whether the given endpoint is ready to serve the traffic, a nil ready condition is to be interpreted as ready
+func isEndpointReady(info discoveryv1.Endpoint) bool {
+	return info.Conditions.Ready == nil || *info.Conditions.Ready
+}
+
+// listEndpointSlices lists the EndpointSlices associated to the service identified by "serviceName" in the given "namespace",
+// i.e. the ones labeled with "kubernetes.io/service-name"
+func (cli *Client) listEndpointSlices(ctx context.Context, namespace, serviceName string) ([]discoveryv1.EndpointSlice, error) {
+	selector := labels.SelectorFromSet(labels.Set{discoveryv1.LabelServiceName: serviceName}).String()
+	response, err := cli.DiscoveryV1().EndpointSlices(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	return response.Items, nil
+}
+
+// GetEndpoints is an API to fetch the backend addresses of the service identified by "serviceName" in the given "namespace".
+// The endpoints of the EndpointSlices associated to the service are flattened, an address present in more than one slice is returned once.
+// The addresses not yet ready to serve the traffic are returned with Ready set to false. A NotFound error (`apierrors.IsNotFound`) is
+// returned if the service doesn't exist.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetEndpoints(namespace, serviceName string) ([]EndpointAddress, error) {
+	return cli.GetEndpointsContext(context.Background(), namespace, serviceName)
+}
+
+// GetEndpointsContext is the context-aware variant of GetEndpoints
+func (cli *Client) GetEndpointsContext(ctx context.Context, namespace, serviceName string) ([]EndpointAddress, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the endpoints information, Namespace: %s, Service: %s\n", namespace, serviceName)
+	slices, err := cli.listEndpointSlices(ctx, namespace, serviceName)
+	if err == nil && len(slices) == 0 {
+		// a service without any slice may not exist at all, which is reported as NotFound
+		_, err = cli.CoreV1().Services(namespace).Get(ctx, serviceName, metav1.GetOptions{})
+	}
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	var endpoints []EndpointAddress
+	// seen avoids listing an address present in more than one slice, ex: while the endpoints are moved between the slices
+	seen := make(map[string]bool)
+	for _, slice := range slices {
+		for _, endpoint := range slice.Endpoints {
+			for _, address := range endpoint.Addresses {
+				if !seen[address] {
+					seen[address] = true
+					endpoints = append(endpoints, newEndpointAddress(endpoint, address))
+				}
+			}
+		}
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", endpoints)
+	return endpoints, nil
+}
+
+// ServiceHealth represents the information of a service present in the kubernetes cluster along with the readiness of its endpoints
+type ServiceHealth struct {
+	// Name of the service
+	Name string `json:"name"`
+	// Namespace of the service
+	Namespace string `json:"namespace"`
+	// Type of the service ex:"ClusterIP/NodePort/LoadBalancer/ExternalName"
+	Type string `json:"type"`
+	// ClusterIP refers to the virtual IP of the service, "None" for a headless service
+	ClusterIP string `json:"clusterIP"`
+	// Ports refers to the ports exposed by the service in the "<port>/<protocol>" format ex:"80/TCP"
+	Ports []string `json:"ports"`
+	// Selector refers to the label selector of the pods backing the service, empty if the endpoints are managed manually
+	Selector string `json:"selector"`
+	// ReadyEndpoints refers to the number of the endpoints ready to serve the traffic, 0 signals the service is down
+	ReadyEndpoints int `json:"readyEndpoints"`
+	// TotalEndpoints refers to the number of the endpoints regardless of their readiness
+	TotalEndpoints int `json:"totalEndpoints"`
+}
+
+// GetServiceHealth is an API to fetch the details of the service identified by "serviceName" in the given "namespace" along with the
+// number of its ready and total endpoints counted from the EndpointSlices associated to the service. An endpoint present in more than
+// one slice, ex: in both the IPv4 and IPv6 slices of a dual-stack service, is counted once.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetServiceHealth(namespace, serviceName string) (ServiceHealth, error) {
+	return cli.GetServiceHealthContext(context.Background(), namespace, serviceName)
+}
+
+// GetServiceHealthContext is the context-aware variant of GetServiceHealth
+func (cli *Client) GetServiceHealthContext(ctx context.Context, namespace, serviceName string) (ServiceHealth, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return ServiceHealth{}, err
+	}
+	log.Printf("Getting the service health information, Namespace: %s, Service: %s\n", namespace, serviceName)
+	service, err := cli.CoreV1().Services(namespace).Get(ctx, serviceName, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return ServiceHealth{}, err
+	}
+	health := ServiceHealth{
+		Name:      service.ObjectMeta.Name,
+		Namespace: service.ObjectMeta.Namespace,
+		Type:      string(service.Spec.Type),
+		ClusterIP: service.Spec.ClusterIP,
+	}
+	for _, port := range service.Spec.Ports {
+		health.Ports = append(health.Ports, fmt.Sprintf("%d/%s", port.Port, port.Protocol))
+	}
+	if len(service.Spec.Selector) > 0 {
+		health.Selector = labels.SelectorFromSet(service.Spec.Selector).String()
+	}
+	slices, err := cli.listEndpointSlices(ctx, namespace, serviceName)
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return ServiceHealth{}, err
+	}
+	// ready holds the readiness of each endpoint keyed by the object backing it (or its addresses if not set)
+	ready := make(map[string]bool)
+	for _, slice := range slices {
+		for _, endpoint := range slice.Endpoints {
+			key := fmt.Sprint(endpoint.Addresses)
+			if endpoint.TargetRef != nil {
+				key = endpoint.TargetRef.Kind + "/" + endpoint.TargetRef.Name
+			}
+			ready[key] = ready[key] || isEndpointReady(endpoint)
+		}
+	}
+	health.TotalEndpoints = len(ready)
+	for _, isReady := range ready {
+		if isReady {
+			health.ReadyEndpoints++
+		}
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", health)
+	return health, nil
+}
@@ -0,0 +1,65 @@
+package apps
+
+import (
+	"testing"
+
+	apiv1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// newEndpointSlice returns an EndpointSlice of the given service holding the given endpoints
+func newEndpointSlice(name, serviceName string, endpoints ...discoveryv1.Endpoint) *discoveryv1.EndpointSlice {
+	return &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels:    map[string]string{discoveryv1.LabelServiceName: serviceName},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+		Endpoints:   endpoints,
+	}
+}
+
+// TestGetEndpoints checks that the endpoints are read from the EndpointSlices of the service
+func TestGetEndpoints(t *testing.T) {
+	ready, notReady := true, false
+	cli, _ := newFakeClient(t,
+		&apiv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "idle", Namespace: "default"}},
+		newEndpointSlice("web-abc", "web",
+			discoveryv1.Endpoint{Addresses: []string{"10.0.0.1"}, Conditions: discoveryv1.EndpointConditions{Ready: &ready},
+				TargetRef: &apiv1.ObjectReference{Kind: "Pod", Name: "web-0"}},
+			discoveryv1.Endpoint{Addresses: []string{"10.0.0.2"}, Conditions: discoveryv1.EndpointConditions{Ready: &notReady}},
+		),
+		// the address moved between the slices is listed once
+		newEndpointSlice("web-def", "web", discoveryv1.Endpoint{Addresses: []string{"10.0.0.1"},
+			TargetRef: &apiv1.ObjectReference{Kind: "Pod", Name: "web-0"}}),
+		newEndpointSlice("api-abc", "api", discoveryv1.Endpoint{Addresses: []string{"10.0.0.9"}}),
+	)
+	endpoints, err := cli.GetEndpoints("default", "web")
+	if err != nil {
+		t.Fatalf("getting the endpoints failed, Err: %v", err)
+	}
+	// the slices are not listed in any particular order, hence the endpoints are compared by their IP
+	expected := map[string]EndpointAddress{
+		"10.0.0.1": {IP: "10.0.0.1", Ready: true, TargetRef: "Pod/web-0"},
+		"10.0.0.2": {IP: "10.0.0.2", Ready: false},
+	}
+	if len(endpoints) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, endpoints)
+	}
+	for _, endpoint := range endpoints {
+		if endpoint != expected[endpoint.IP] {
+			t.Errorf("expected %v, got %v", expected[endpoint.IP], endpoint)
+		}
+	}
+
+	endpoints, err = cli.GetEndpoints("default", "idle")
+	if err != nil || len(endpoints) != 0 {
+		t.Errorf("expected no endpoints for a service without slices, got %v, Err: %v", endpoints, err)
+	}
+	if _, err := cli.GetEndpoints("default", "missing"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected a NotFound error for a missing service, Err: %v", err)
+	}
+}
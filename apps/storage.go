@@ -0,0 +1,72 @@
+package apps
+
+import (
+	"context"
+	"log"
+
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// defaultStorageClassAnnotation refers to the annotation marking a storage class as the default of the cluster
+	defaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+	// betaDefaultStorageClassAnnotation refers to the beta version of the default storage class annotation, still honored by the API server
+	betaDefaultStorageClassAnnotation = "storageclass.beta.kubernetes.io/is-default-class"
+)
+
+// StorageClass represents the information of a storage class present in the kubernetes cluster
+type StorageClass struct {
+	// Name of the storage class
+	Name string `json:"name"`
+	// Provisioner refers to the volume plugin provisioning the volumes ex:"ebs.csi.aws.com"
+	Provisioner string `json:"provisioner"`
+	// ReclaimPolicy refers to what happens to a dynamically provisioned volume once released ex:"Delete/Retain"
+	ReclaimPolicy string `json:"reclaimPolicy"`
+	// VolumeBindingMode refers to when the volumes are provisioned and bound ex:"Immediate/WaitForFirstConsumer"
+	VolumeBindingMode string `json:"volumeBindingMode"`
+	// IsDefault represents if the storage class is the default one, used by the claims which don't request a class
+	IsDefault bool `json:"isDefault"`
+}
+
+// newStorageClass maps the given kubernetes storage class to the StorageClass information
+func newStorageClass(info storagev1.StorageClass) StorageClass {
+	storageClass := StorageClass{
+		Name:        info.ObjectMeta.Name,
+		Provisioner: info.Provisioner,
+		IsDefault: info.ObjectMeta.Annotations[defaultStorageClassAnnotation] == "true" ||
+			info.ObjectMeta.Annotations[betaDefaultStorageClassAnnotation] == "true",
+	}
+	if info.ReclaimPolicy != nil {
+		storageClass.ReclaimPolicy = string(*info.ReclaimPolicy)
+	}
+	if info.VolumeBindingMode != nil {
+		storageClass.VolumeBindingMode = string(*info.VolumeBindingMode)
+	}
+	return storageClass
+}
+
+// GetStorageClasses is an API to fetch the storage classes of the cluster. Storage classes are cluster-scoped, hence there is no namespace argument.
+func (cli *Client) GetStorageClasses() ([]StorageClass, error) {
+	return cli.GetStorageClassesContext(context.Background())
+}
+
+// GetStorageClassesContext is the context-aware variant of GetStorageClasses
+func (cli *Client) GetStorageClassesContext(ctx context.Context) ([]StorageClass, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	log.Printf("Getting the storage classes information\n")
+	storageClasses, err := listAndMap(func() ([]storagev1.StorageClass, error) {
+		response, err := cli.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return response.Items, nil
+	}, newStorageClass)
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", storageClasses)
+	return storageClasses, nil
+}
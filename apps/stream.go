@@ -0,0 +1,63 @@
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// podsPageSize refers to the maximum number of the pods fetched by a single list call while streaming
+const podsPageSize = 500
+
+// StreamPods is an API to write the details of all the pods present in a given "namespace" to "w" as a JSON array.
+// The pods are listed page by page and each of them is encoded as soon as it is converted, so that only a single page is resident in the
+// memory regardless of the size of the cluster. The output is incomplete (not a valid JSON array) if an error is returned midway.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) StreamPods(ctx context.Context, namespace string, w io.Writer) error {
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return err
+	}
+	log.Printf("Streaming the pods information, Namespace: %s\n", namespace)
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	count := 0
+	opts := metav1.ListOptions{Limit: podsPageSize}
+	for {
+		requestCtx, cancel := cli.requestContext(ctx)
+		response, err := cli.CoreV1().Pods(namespace).List(requestCtx, opts)
+		cancel()
+		if err != nil {
+			log.Printf("Failed getting response from k8s API, Err: %v", err)
+			return err
+		}
+		for _, info := range response.Items {
+			data, err := json.Marshal(newPod(info))
+			if err != nil {
+				return err
+			}
+			if count > 0 {
+				if _, err := io.WriteString(w, ","); err != nil {
+					return err
+				}
+			}
+			if _, err := w.Write(data); err != nil {
+				return err
+			}
+			count++
+		}
+		if response.Continue == "" {
+			break
+		}
+		opts.Continue = response.Continue
+	}
+	if _, err := io.WriteString(w, "]"); err != nil {
+		return err
+	}
+	log.Printf("Streamed the pods successfully, Count: %d\n", count)
+	return nil
+}
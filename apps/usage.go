@@ -0,0 +1,259 @@
+package apps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+
+	"golang.org/x/sync/errgroup"
+	apiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+// ErrMetricsUnavailable is returned when the resource metrics API (metrics.k8s.io) is not served by the cluster, i.e. the metrics-server is not installed
+var ErrMetricsUnavailable = errors.New("resource metrics API is not available, is the metrics-server installed?")
+
+// NodeMetrics represents the current resource usage of a node present in the kubernetes cluster
+type NodeMetrics struct {
+	// Name of the node
+	Name string `json:"name"`
+	// CPUMillicores refers to the CPU usage of the node in millicores
+	CPUMillicores int64 `json:"cpuMillicores"`
+	// MemoryBytes refers to the memory usage (working set) of the node in bytes
+	MemoryBytes int64 `json:"memoryBytes"`
+}
+
+// metricsError wraps the given error with ErrMetricsUnavailable if it indicates that the resource metrics API is not served
+func metricsError(err error) error {
+	if apierrors.IsNotFound(err) || apierrors.IsServiceUnavailable(err) {
+		return fmt.Errorf("%w: %w", ErrMetricsUnavailable, err)
+	}
+	return err
+}
+
+// newNodeMetrics maps the given metrics of a node to the NodeMetrics information
+func newNodeMetrics(info metricsv1beta1.NodeMetrics) NodeMetrics {
+	return NodeMetrics{
+		Name:          info.ObjectMeta.Name,
+		CPUMillicores: info.Usage.Cpu().MilliValue(),
+		MemoryBytes:   info.Usage.Memory().Value(),
+	}
+}
+
+// GetNodeMetrics is an API to fetch the current CPU and memory usage of all the nodes from the metrics-server.
+// Combined with the capacity of the nodes this helps in computing their utilization.
+// An error wrapping ErrMetricsUnavailable is returned if the metrics-server is not present in the cluster.
+func (cli *Client) GetNodeMetrics() ([]NodeMetrics, error) {
+	return cli.GetNodeMetricsContext(context.Background())
+}
+
+// GetNodeMetricsContext is the context-aware variant of GetNodeMetrics
+func (cli *Client) GetNodeMetricsContext(ctx context.Context) ([]NodeMetrics, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	log.Printf("Getting the node metrics information\n")
+	nodeMetrics, err := listAndMap(func() ([]metricsv1beta1.NodeMetrics, error) {
+		response, err := cli.metricsClient.MetricsV1beta1().NodeMetricses().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return response.Items, nil
+	}, newNodeMetrics)
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, metricsError(err)
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", nodeMetrics)
+	return nodeMetrics, nil
+}
+
+// PodMetrics represents the current resource usage of a pod, summed across its containers
+type PodMetrics struct {
+	// Name of the pod
+	Name string `json:"name"`
+	// CPUMillicores refers to the CPU usage of the pod in millicores
+	CPUMillicores int64 `json:"cpuMillicores"`
+	// MemoryBytes refers to the memory usage (working set) of the pod in bytes
+	MemoryBytes int64 `json:"memoryBytes"`
+}
+
+// PodUsage represents the current resource usage of a pod relative to its resource requests, similar to `kubectl top pods`
+type PodUsage struct {
+	// Name of the pod
+	Name string `json:"name"`
+	// CPUMillicores refers to the CPU usage of the pod in millicores
+	CPUMillicores int64 `json:"cpuMillicores"`
+	// MemoryBytes refers to the memory usage (working set) of the pod in bytes
+	MemoryBytes int64 `json:"memoryBytes"`
+	// CPUPercent refers to the CPU usage as a percentage of the pod's CPU request, zero if the pod has no CPU request
+	CPUPercent float64 `json:"cpuPercent"`
+	// MemoryPercent refers to the memory usage as a percentage of the pod's memory request, zero if the pod has no memory request
+	MemoryPercent float64 `json:"memoryPercent"`
+}
+
+// newPodMetrics maps the given metrics of a pod to the PodMetrics information, summed across its containers
+func newPodMetrics(info metricsv1beta1.PodMetrics) PodMetrics {
+	metrics := PodMetrics{Name: info.ObjectMeta.Name}
+	for _, container := range info.Containers {
+		metrics.CPUMillicores += container.Usage.Cpu().MilliValue()
+		metrics.MemoryBytes += container.Usage.Memory().Value()
+	}
+	return metrics
+}
+
+// GetPodMetrics is an API to fetch the current CPU and memory usage of all the pods present in a given "namespace" from the metrics-server.
+// An error wrapping ErrMetricsUnavailable is returned if the metrics-server is not present in the cluster.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) GetPodMetrics(namespace string) ([]PodMetrics, error) {
+	return cli.GetPodMetricsContext(context.Background(), namespace)
+}
+
+// GetPodMetricsContext is the context-aware variant of GetPodMetrics
+func (cli *Client) GetPodMetricsContext(ctx context.Context, namespace string) ([]PodMetrics, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Getting the pod metrics information, Namespace: %s\n", namespace)
+	podMetrics, err := listAndMap(func() ([]metricsv1beta1.PodMetrics, error) {
+		response, err := cli.metricsClient.MetricsV1beta1().PodMetricses(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return response.Items, nil
+	}, newPodMetrics)
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, metricsError(err)
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", podMetrics)
+	return podMetrics, nil
+}
+
+// TopPods is an API to fetch the current resource usage of all the pods present in a given "namespace" along with the usage as a
+// percentage of their resource requests. The pods are sorted by their CPU usage in the descending order.
+// namespace defaults to the client's default namespace if the argument passed is an empty string ("")
+func (cli *Client) TopPods(namespace string) ([]PodUsage, error) {
+	return cli.TopPodsContext(context.Background(), namespace)
+}
+
+// TopPodsContext is the context-aware variant of TopPods
+func (cli *Client) TopPodsContext(ctx context.Context, namespace string) ([]PodUsage, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	namespace, err := cli.resolveNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	podMetrics, err := cli.GetPodMetricsContext(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := cli.listPods(ctx, namespace, metav1.ListOptions{})
+	if err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return nil, err
+	}
+	podsByName := make(map[string]Pod, len(pods))
+	for _, pod := range pods {
+		podsByName[pod.Name] = pod
+	}
+
+	var usages []PodUsage
+	for _, metrics := range podMetrics {
+		usage := PodUsage{
+			Name:          metrics.Name,
+			CPUMillicores: metrics.CPUMillicores,
+			MemoryBytes:   metrics.MemoryBytes,
+		}
+		if pod, ok := podsByName[metrics.Name]; ok {
+			if cpuRequest := pod.CPURequest.MilliValue(); cpuRequest > 0 {
+				usage.CPUPercent = float64(metrics.CPUMillicores) * 100 / float64(cpuRequest)
+			}
+			if memoryRequest := pod.MemoryRequest.Value(); memoryRequest > 0 {
+				usage.MemoryPercent = float64(metrics.MemoryBytes) * 100 / float64(memoryRequest)
+			}
+		}
+		usages = append(usages, usage)
+	}
+	sort.SliceStable(usages, func(i, j int) bool {
+		return usages[i].CPUMillicores > usages[j].CPUMillicores
+	})
+	log.Printf("Fetched information successfully, Info: %v\n", usages)
+	return usages, nil
+}
+
+// ResourceUsage represents how much of the allocatable capacity of the cluster is requested by the pods, i.e. how full the cluster is for the scheduler
+type ResourceUsage struct {
+	// CPURequestMillicores refers to the sum of the CPU requests of all the pods in millicores
+	CPURequestMillicores int64 `json:"cpuRequestMillicores"`
+	// CPUAllocatableMillicores refers to the sum of the allocatable CPU of all the nodes in millicores
+	CPUAllocatableMillicores int64 `json:"cpuAllocatableMillicores"`
+	// CPUPercent refers to the requested CPU as a percentage of the allocatable CPU
+	CPUPercent float64 `json:"cpuPercent"`
+	// MemoryRequestBytes refers to the sum of the memory requests of all the pods in bytes
+	MemoryRequestBytes int64 `json:"memoryRequestBytes"`
+	// MemoryAllocatableBytes refers to the sum of the allocatable memory of all the nodes in bytes
+	MemoryAllocatableBytes int64 `json:"memoryAllocatableBytes"`
+	// MemoryPercent refers to the requested memory as a percentage of the allocatable memory
+	MemoryPercent float64 `json:"memoryPercent"`
+}
+
+// GetClusterResourceUsage is an API to fetch the CPU and memory requested by the pods across all the namespaces against the allocatable
+// capacity of all the nodes, along with the percentages. The pods which have run to completion (Succeeded/Failed) are left out as they
+// don't hold any resources. The pods and the nodes are fetched concurrently. The percentages are 0 when no capacity is allocatable.
+func (cli *Client) GetClusterResourceUsage() (ResourceUsage, error) {
+	return cli.GetClusterResourceUsageContext(context.Background())
+}
+
+// GetClusterResourceUsageContext is the context-aware variant of GetClusterResourceUsage
+func (cli *Client) GetClusterResourceUsageContext(ctx context.Context) (ResourceUsage, error) {
+	ctx, cancel := cli.requestContext(ctx)
+	defer cancel()
+	log.Printf("Getting the cluster resource usage information\n")
+	var usage ResourceUsage
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.Go(func() error {
+		pods, err := cli.listPods(groupCtx, metav1.NamespaceAll, metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("listing pods: %w", err)
+		}
+		for _, pod := range pods {
+			if pod.Status == string(apiv1.PodSucceeded) || pod.Status == string(apiv1.PodFailed) {
+				continue
+			}
+			usage.CPURequestMillicores += pod.CPURequest.MilliValue()
+			usage.MemoryRequestBytes += pod.MemoryRequest.Value()
+		}
+		return nil
+	})
+	group.Go(func() error {
+		nodes, err := cli.CoreV1().Nodes().List(groupCtx, metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("listing nodes: %w", err)
+		}
+		for _, node := range nodes.Items {
+			usage.CPUAllocatableMillicores += node.Status.Allocatable.Cpu().MilliValue()
+			usage.MemoryAllocatableBytes += node.Status.Allocatable.Memory().Value()
+		}
+		return nil
+	})
+	if err := group.Wait(); err != nil {
+		log.Printf("Failed getting response from k8s API, Err: %v", err)
+		return ResourceUsage{}, err
+	}
+	if usage.CPUAllocatableMillicores > 0 {
+		usage.CPUPercent = float64(usage.CPURequestMillicores) * 100 / float64(usage.CPUAllocatableMillicores)
+	}
+	if usage.MemoryAllocatableBytes > 0 {
+		usage.MemoryPercent = float64(usage.MemoryRequestBytes) * 100 / float64(usage.MemoryAllocatableBytes)
+	}
+	log.Printf("Fetched information successfully, Info: %v\n", usage)
+	return usage, nil
+}
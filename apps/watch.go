@@ -0,0 +1,205 @@
+package apps
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math/rand"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+const (
+	// watchInitialBackoff refers to the time waited before the first attempt of re-establishing a watch which has ended or failed
+	watchInitialBackoff = time.Second
+	// defaultWatchMaxBackoff refers to the default cap of the time waited between the attempts of re-establishing a watch
+	defaultWatchMaxBackoff = 30 * time.Second
+	// watchHealthyPeriod refers to the time after which a running watch is considered healthy and the backoff is reset
+	watchHealthyPeriod = time.Minute
+)
+
+// watchBackoff computes the exponentially growing delays, with jitter, between the attempts of re-establishing a watch.
+// The jitter spreads the reconnections of many clients so that they don't hammer a recovering API server at once.
+type watchBackoff struct {
+	// max refers to the cap of the delay
+	max time.Duration
+	// next refers to the delay before jitter of the next attempt
+	next time.Duration
+}
+
+// delay returns the time to wait before the next attempt, a random duration between the half and the whole of the current backoff
+func (b *watchBackoff) delay() time.Duration {
+	if b.next == 0 {
+		b.next = watchInitialBackoff
+	}
+	current := min(b.next, b.max)
+	b.next = min(b.next*2, b.max)
+	return current/2 + time.Duration(rand.Int63n(int64(current/2)+1))
+}
+
+// reset starts the backoff over from the initial delay
+func (b *watchBackoff) reset() {
+	b.next = 0
+}
+
+// resourceWatcher holds the functions required to keep a watch running on a collection of resources
+type resourceWatcher struct {
+	// list returns the current resource version of the collection being watched
+	list func(ctx context.Context) (string, error)
+	// watch starts a watch on the collection with the given list options
+	watch func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	// onError, if set, is called with the errors which interrupt the watch before it is re-established, to deliver them to the consumers
+	onError func(err error)
+}
+
+// report passes the given error to the error handler of the watcher, if any
+func (rw resourceWatcher) report(err error) {
+	if rw.onError != nil {
+		rw.onError(err)
+	}
+}
+
+// sleepWithContext waits for the given duration and returns false if the context is done in the meantime
+func sleepWithContext(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// runWatch keeps a watch on the collection running from the given resource version until the context is done.
+// The watch is re-established from the last seen resource version whenever it ends or fails, and from a fresh resource version
+// (obtained by listing) when the last seen one has expired. The attempts are spaced by an exponential backoff capped by the
+// client's maximum watch backoff, which is reset once a watch has stayed up for a while.
+// "handle" is called with every Added/Modified/Deleted event.
+func (cli *Client) runWatch(ctx context.Context, resourceVersion string, rw resourceWatcher, handle func(event watch.Event)) {
+	backoff := watchBackoff{max: cli.watchMaxBackoff}
+	for ctx.Err() == nil {
+		if resourceVersion == "" {
+			rv, err := rw.list(ctx)
+			if err != nil {
+				log.Printf("Failed listing the resources to re-establish the watch, Err: %v", err)
+				rw.report(err)
+				sleepWithContext(ctx, backoff.delay())
+				continue
+			}
+			resourceVersion = rv
+		}
+		watcher, err := rw.watch(ctx, metav1.ListOptions{ResourceVersion: resourceVersion, AllowWatchBookmarks: true})
+		if err != nil {
+			log.Printf("Failed establishing the watch, Err: %v", err)
+			rw.report(err)
+			sleepWithContext(ctx, backoff.delay())
+			continue
+		}
+		started := time.Now()
+		resourceVersion = consumeWatch(ctx, watcher, resourceVersion, handle, rw.report)
+		if time.Since(started) >= watchHealthyPeriod {
+			backoff.reset()
+		}
+		if ctx.Err() == nil {
+			log.Printf("Watch ended, re-establishing it from the resource version: %q\n", resourceVersion)
+			sleepWithContext(ctx, backoff.delay())
+		}
+	}
+}
+
+// ErrWatchClosed is the error of the last Result of a watch which has been closed by the API server, the caller is expected to re-establish it
+var ErrWatchClosed = errors.New("watch closed by the server")
+
+// Result represents an event of a watch with its object converted to T, or the error which ended the watch
+type Result[T any] struct {
+	// Type of the event ex:"ADDED/MODIFIED/DELETED/BOOKMARK/ERROR"
+	Type watch.EventType
+	// Object refers to the converted object of the event, the zero value for the bookmarks and the errors
+	Object T
+	// ResourceVersion refers to the resource version of the event's object, from which the watch can be re-established
+	ResourceVersion string
+	// Err refers to the error of converting the object, or the error which ended the watch
+	Err error
+}
+
+// Watch runs the event loop of the given watch, passing the objects of its events through "convert", until the watch ends or the
+// context is done, and returns the channel of the results. A failed conversion is reported by a result carrying the error and the loop
+// goes on. The end of the watch is signaled by a last result carrying either ErrWatchClosed, when the server closes the watch, or the
+// error sent by the server (a watch.Error event, ex: an expired resource version satisfying `apierrors.IsResourceExpired`), after which
+// the channel is closed and the watch has to be re-established by the caller from the last seen resource version.
+// The channel is closed without a final result once the context is done. The watch is always stopped on return.
+func Watch[T any](ctx context.Context, watcher watch.Interface, convert func(object runtime.Object) (T, error)) <-chan Result[T] {
+	results := make(chan Result[T])
+	go func() {
+		defer close(results)
+		defer watcher.Stop()
+		send := func(result Result[T]) bool {
+			select {
+			case results <- result:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.ResultChan():
+				if !ok {
+					send(Result[T]{Err: ErrWatchClosed})
+					return
+				}
+				switch event.Type {
+				case watch.Added, watch.Modified, watch.Deleted, watch.Bookmark:
+					result := Result[T]{Type: event.Type}
+					if object, err := meta.Accessor(event.Object); err == nil {
+						result.ResourceVersion = object.GetResourceVersion()
+					}
+					if event.Type != watch.Bookmark {
+						result.Object, result.Err = convert(event.Object)
+					}
+					if !send(result) {
+						return
+					}
+				case watch.Error:
+					send(Result[T]{Type: watch.Error, Err: apierrors.FromObject(event.Object)})
+					return
+				}
+			}
+		}
+	}()
+	return results
+}
+
+// consumeWatch passes the events of the watch to "handle" until the watch ends or the context is done. The errors sent by the server are
+// passed to "report", except for an expired resource version which is recovered from transparently.
+// It returns the last seen resource version, empty if the resource version has expired and the collection has to be listed again.
+func consumeWatch(ctx context.Context, watcher watch.Interface, resourceVersion string, handle func(event watch.Event), report func(err error)) string {
+	results := Watch(ctx, watcher, func(object runtime.Object) (runtime.Object, error) { return object, nil })
+	for result := range results {
+		if result.ResourceVersion != "" {
+			resourceVersion = result.ResourceVersion
+		}
+		switch {
+		case errors.Is(result.Err, ErrWatchClosed):
+			return resourceVersion
+		case result.Err != nil:
+			log.Printf("Watch failed, Err: %v", result.Err)
+			if apierrors.IsResourceExpired(result.Err) || apierrors.IsGone(result.Err) {
+				return ""
+			}
+			report(result.Err)
+			return resourceVersion
+		case result.Type != watch.Bookmark:
+			handle(watch.Event{Type: result.Type, Object: result.Object})
+		}
+	}
+	return resourceVersion
+}
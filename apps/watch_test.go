@@ -0,0 +1,140 @@
+package apps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// TestWatchBackoff checks that the delays of the backoff stay within the jitter bounds, grow up to the cap and start over on reset
+func TestWatchBackoff(t *testing.T) {
+	backoff := watchBackoff{max: 4 * time.Second}
+	for _, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second} {
+		if got := backoff.delay(); got < want/2 || got > want {
+			t.Errorf("expected a delay between %v and %v, got: %v", want/2, want, got)
+		}
+	}
+	backoff.reset()
+	if got := backoff.delay(); got < watchInitialBackoff/2 || got > watchInitialBackoff {
+		t.Errorf("expected the delay to start over from %v after reset, got: %v", watchInitialBackoff, got)
+	}
+}
+
+// TestRunWatchReestablishes checks that a watch closed by the server is re-established from the last seen resource version
+func TestRunWatchReestablishes(t *testing.T) {
+	cli, _ := newFakeClient(t)
+	cli.watchMaxBackoff = time.Millisecond
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	first, second := watch.NewFake(), watch.NewFake()
+	watchers := []*watch.FakeWatcher{first, second}
+	var versions []string
+	rw := resourceWatcher{
+		list: func(ctx context.Context) (string, error) {
+			return "1", nil
+		},
+		watch: func(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+			versions = append(versions, opts.ResourceVersion)
+			watcher := watchers[0]
+			watchers = watchers[1:]
+			return watcher, nil
+		},
+	}
+	go func() {
+		first.Add(&apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-0", ResourceVersion: "5"}})
+		first.Stop()
+		second.Modify(&apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-0", ResourceVersion: "6"}})
+	}()
+
+	var events []watch.EventType
+	cli.runWatch(ctx, "", rw, func(event watch.Event) {
+		events = append(events, event.Type)
+		if len(events) == 2 {
+			cancel()
+		}
+	})
+	if len(events) != 2 || events[0] != watch.Added || events[1] != watch.Modified {
+		t.Fatalf("expected the Added and Modified events, got: %v", events)
+	}
+	if len(versions) != 2 || versions[0] != "1" || versions[1] != "5" {
+		t.Errorf("expected the watch to be established from the listed resource version and re-established from the last seen one, got: %v", versions)
+	}
+}
+
+// convertPodName converts the objects of the watch to the name of their pod
+func convertPodName(object runtime.Object) (string, error) {
+	pod, ok := object.(*apiv1.Pod)
+	if !ok {
+		return "", fmt.Errorf("unexpected object %T", object)
+	}
+	return pod.Name, nil
+}
+
+// TestWatchResults checks that the events are converted in order, that a failed conversion doesn't end the watch and that
+// the end of the watch is signaled by a last ErrWatchClosed result
+func TestWatchResults(t *testing.T) {
+	watcher := watch.NewFake()
+	go func() {
+		watcher.Add(&apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-0", ResourceVersion: "5"}})
+		watcher.Modify(&apiv1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1", ResourceVersion: "6"}})
+		watcher.Action(watch.Bookmark, &apiv1.Pod{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "7"}})
+		watcher.Stop()
+	}()
+
+	var results []Result[string]
+	for result := range Watch(context.Background(), watcher, convertPodName) {
+		results = append(results, result)
+	}
+	if len(results) != 4 {
+		t.Fatalf("expected 4 results, got: %+v", results)
+	}
+	if results[0].Type != watch.Added || results[0].Object != "web-0" || results[0].ResourceVersion != "5" || results[0].Err != nil {
+		t.Errorf("unexpected result of the Added event: %+v", results[0])
+	}
+	if results[1].Type != watch.Modified || results[1].ResourceVersion != "6" || results[1].Err == nil {
+		t.Errorf("expected the failed conversion to be reported, got: %+v", results[1])
+	}
+	if results[2].Type != watch.Bookmark || results[2].ResourceVersion != "7" || results[2].Err != nil {
+		t.Errorf("unexpected result of the Bookmark event: %+v", results[2])
+	}
+	if !errors.Is(results[3].Err, ErrWatchClosed) {
+		t.Errorf("expected the watch to end with ErrWatchClosed, got: %v", results[3].Err)
+	}
+}
+
+// TestWatchServerError checks that an Error event ends the watch with the error sent by the server
+func TestWatchServerError(t *testing.T) {
+	watcher := watch.NewFake()
+	go watcher.Error(&apierrors.NewResourceExpired("too old resource version").ErrStatus)
+
+	var results []Result[string]
+	for result := range Watch(context.Background(), watcher, convertPodName) {
+		results = append(results, result)
+	}
+	if len(results) != 1 || results[0].Type != watch.Error || !apierrors.IsResourceExpired(results[0].Err) {
+		t.Fatalf("expected a single result carrying the expired resource version, got: %+v", results)
+	}
+}
+
+// TestWatchContextDone checks that the results are closed without a final result once the context is done
+func TestWatchContextDone(t *testing.T) {
+	watcher := watch.NewFake()
+	ctx, cancel := context.WithCancel(context.Background())
+	results := Watch(ctx, watcher, convertPodName)
+	cancel()
+	for result := range results {
+		t.Errorf("expected no result once the context is done, got: %+v", result)
+	}
+	if !watcher.IsStopped() {
+		t.Errorf("expected the watch to be stopped")
+	}
+}